@@ -14,10 +14,21 @@ func main() {
 	cfg := config.Load()
 	logger := logging.New(cfg.Logging.Level)
 
-	application := app.New(cfg, logger)
+	application, err := app.New(cfg, logger)
+	if err != nil {
+		logger.Error("application setup failed", "error", err)
+		os.Exit(1)
+	}
+	defer application.Close()
 
-	if err := application.Run(ctx); err != nil {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		err = application.Migrate(ctx, os.Args[2:], os.Stdout)
+	} else {
+		err = application.Run(ctx)
+	}
+	if err != nil {
 		logger.Error("application stopped", "error", err)
+		_ = application.Close()
 		os.Exit(1)
 	}
 }
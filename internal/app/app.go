@@ -2,46 +2,167 @@ package app
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"net/http"
 	"time"
 
 	"ArticlesScanner/internal/config"
+	"ArticlesScanner/internal/infrastructure/enrichment"
+	"ArticlesScanner/internal/infrastructure/httpcache"
 	"ArticlesScanner/internal/infrastructure/llm"
+	"ArticlesScanner/internal/infrastructure/ml"
 	"ArticlesScanner/internal/infrastructure/parser"
+	"ArticlesScanner/internal/infrastructure/telegram"
+	"ArticlesScanner/internal/infrastructure/transport"
 	"ArticlesScanner/internal/logging"
 	"ArticlesScanner/internal/ports"
 	"ArticlesScanner/internal/scanner"
 	"ArticlesScanner/internal/usecase"
 )
 
+// Timeouts cover a whole call through the shared transport, including rate-limit waits and retries.
+const (
+	scannerTimeout = 2 * time.Minute
+	apiTimeout     = time.Minute
+)
+
 // Application wires configs to use cases and lifecycle orchestration.
 type Application struct {
 	cfg      config.Config
+	database *database
+	logger   *slog.Logger
 	pipeline *usecase.Pipeline
 }
 
 // New builds a minimal runnable application instance.
-func New(cfg config.Config, baseLogger *slog.Logger) *Application {
+func New(cfg config.Config, baseLogger *slog.Logger) (*Application, error) {
 	if baseLogger == nil {
 		baseLogger = logging.New(cfg.Logging.Level)
 	}
 
+	database, err := openDatabase(cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+	// Ports stay nil interfaces without a database so the pipeline skips storage instead of calling a nil repository.
+	var (
+		repository ports.ArticleRepository
+		versions   ports.VersionTracker
+		runs       ports.RunStore
+		archive    ports.ArticleArchive
+		outcomes   ports.ScanOutcomeStore
+		cache      ports.EnrichmentCache
+	)
+	if database != nil {
+		repo := database.repo
+		repository, versions, runs, archive, outcomes, cache = repo, repo, repo, repo, repo, repo
+		if backend, _ := cfg.Database.Backend(); backend == config.DatabaseMemory {
+			baseLogger.Warn("database.dsn is memory:, processed articles are forgotten on exit")
+		}
+	} else {
+		baseLogger.Warn("database.dsn is empty, running without storage: articles are not deduplicated")
+	}
+
+	httpTransport := transport.New(cfg.HTTP, nil, baseLogger.With("component", "http"))
+	scanClient := newScanClient(cfg.HTTP.Cache, httpTransport, baseLogger.With("component", "httpcache"))
+	apiClient := httpTransport.Client(apiTimeout)
+
 	registry := scanner.NewRegistry()
-	registry.Register(parser.NewArxivScanner(nil, baseLogger.With("component", "scanner.arxiv")))
+	registry.Register(parser.NewArxivScanner(scanClient, newArxivCalendar(cfg.Arxiv, baseLogger), baseLogger.With("component", "scanner.arxiv")))
+	registry.Register(parser.NewArxivOAIScanner(scanClient, baseLogger.With("component", "scanner.arxiv-oai")))
+	registry.Register(parser.NewOAIScanner(scanClient, baseLogger.With("component", "scanner.oai")))
+	registry.Register(parser.NewArxivAPIScanner(scanClient, baseLogger.With("component", "scanner.arxiv-api")))
+	registry.Register(parser.NewRSSScanner(scanClient, baseLogger.With("component", "scanner.rss")))
+	registry.Register(parser.NewPubMedScanner(scanClient, baseLogger.With("component", "scanner.pubmed")))
+	registry.Register(parser.NewBiorxivScanner(scanClient, baseLogger.With("component", "scanner.biorxiv")))
+	registry.Register(parser.NewSelectorScanner(scanClient, baseLogger.With("component", "scanner.selector")))
+	registry.Register(parser.NewJSONAPIScanner(scanClient, cfg.Providers.ArticleAPIURL, baseLogger.With("component", "scanner.jsonapi")))
+	registry.Register(parser.NewOpenReviewScanner(scanClient, baseLogger.With("component", "scanner.openreview")))
+	registry.Register(parser.NewCrossrefScanner(scanClient, baseLogger.With("component", "scanner.crossref")))
+	registry.Register(parser.NewACLScanner(scanClient, baseLogger.With("component", "scanner.acl")))
+	registry.Register(parser.NewDBLPScanner(scanClient, baseLogger.With("component", "scanner.dblp")))
+	registry.Register(parser.NewMailboxScanner(baseLogger.With("component", "scanner.mailbox")))
+	registry.Register(parser.NewDirectoryScanner(baseLogger.With("component", "scanner.directory")))
 
-	source := parser.NewStrategySource(registry, cfg.Sites, baseLogger.With("component", "source"))
+	source := parser.NewStrategySource(registry, cfg.Sites, cfg.Scan, outcomes, baseLogger.With("component", "source"))
 
 	var chatClient ports.ChatClient
 	if cfg.ChatGPT.APIKey != "" {
-		chatClient = llm.NewChatGPTClient(cfg.ChatGPT)
+		chatClient = llm.NewChatGPTClient(cfg.ChatGPT, apiClient)
+	}
+
+	var (
+		analyzer   ports.Analyzer
+		summarizer ports.Summarizer
+	)
+	if cfg.ML.InferenceURL != "" {
+		mlClient := ml.NewClient(cfg.ML.InferenceURL, cfg.ML.APIKey, cfg.ML.Model, apiClient)
+		analyzer, summarizer = mlClient, mlClient
+	}
+
+	var notifier ports.Notifier
+	if cfg.Notifications.Telegram.BotToken != "" && cfg.Notifications.Telegram.ChatID != "" {
+		notifier = telegram.NewNotifier(cfg.Notifications.Telegram.BotToken, cfg.Notifications.Telegram.ChatID, apiClient)
 	}
 
 	pipeline := usecase.NewPipeline(usecase.PipelineDeps{
 		Source:     source,
+		Repository: repository,
+		Versions:   versions,
+		Runs:       runs,
+		Archive:    archive,
+		Enricher:   newEnricher(cfg.Enrichment, apiClient, cache, baseLogger.With("component", "enrichment")),
+		Analyzer:   analyzer,
+		Summarizer: summarizer,
+		Notifier:   notifier,
 		ChatClient: chatClient,
 		Logger:     baseLogger.With("component", "pipeline"),
+
+		RenotifyRevisions: cfg.Pipeline.RenotifyRevisions,
 	})
-	return &Application{cfg: cfg, pipeline: pipeline}
+	return &Application{cfg: cfg, database: database, logger: baseLogger, pipeline: pipeline}, nil
+}
+
+// newScanClient puts the on-disk cache in front of the scanner transport so cache hits skip rate limits.
+func newScanClient(cfg config.HTTPCacheConfig, httpTransport *transport.Transport, logger *slog.Logger) *http.Client {
+	scanTransport := httpTransport.ForScanners()
+	if cfg.Dir == "" {
+		if cfg.Offline {
+			logger.Warn("offline mode needs http.cache.dir, fetching live")
+		}
+		return scanTransport.Client(scannerTimeout)
+	}
+	return &http.Client{Timeout: scannerTimeout, Transport: httpcache.New(cfg, scanTransport, logger)}
+}
+
+// newArxivCalendar applies the configured holiday table; a malformed table falls back to plain weekdays.
+func newArxivCalendar(cfg config.ArxivConfig, logger *slog.Logger) *parser.ArxivCalendar {
+	calendar, err := parser.NewArxivCalendar(cfg.Holidays)
+	if err != nil {
+		logger.Warn("ignoring arxiv holidays", "error", err)
+		return nil
+	}
+	return calendar
+}
+
+// newEnricher chains configured providers; the result is nil when enrichment is disabled.
+func newEnricher(cfg config.EnrichmentConfig, client *http.Client, cache ports.EnrichmentCache, logger *slog.Logger) ports.Enricher {
+	var providers []ports.Enricher
+	for _, name := range cfg.Providers {
+		switch name {
+		case "semanticscholar":
+			providers = append(providers, enrichment.NewSemanticScholar(cfg.SemanticScholar, client))
+		case "openalex":
+			providers = append(providers, enrichment.NewOpenAlex(cfg.OpenAlex, client))
+		default:
+			logger.Warn("unknown enrichment provider", "provider", name)
+		}
+	}
+	if len(providers) == 0 {
+		return nil
+	}
+	return enrichment.NewCached(enrichment.NewChain(logger, providers...), cache, cfg.CacheTTL)
 }
 
 // Run performs a single pipeline execution placeholder; later plug scheduler.
@@ -50,6 +171,24 @@ func (a *Application) Run(ctx context.Context) error {
 		return nil
 	}
 
+	if a.database != nil && a.database.db != nil && a.cfg.Database.MigrateOnStart {
+		if _, err := a.migrateUp(ctx); err != nil {
+			return err
+		}
+	}
+
 	now := time.Now().In(a.cfg.Scheduler.Location())
-	return a.pipeline.ProcessDay(ctx, now)
+	var resumeErr error
+	if a.cfg.Pipeline.Resume {
+		resumeErr = a.pipeline.Resume(ctx, now)
+	}
+	return errors.Join(resumeErr, a.pipeline.ProcessDay(ctx, now))
+}
+
+// Close releases the database pool and flushes a file-backed journal.
+func (a *Application) Close() error {
+	if a.database == nil {
+		return nil
+	}
+	return a.database.close()
 }
@@ -0,0 +1,92 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"ArticlesScanner/internal/infrastructure/storage/migrate"
+)
+
+// Migrate runs the migrate command: "up" (default), "down [steps]" (default 1) or "status".
+func (a *Application) Migrate(ctx context.Context, args []string, out io.Writer) error {
+	if a.database == nil {
+		return errors.New("migrate: database.dsn is not configured")
+	}
+	if a.database.db == nil {
+		return errors.New("migrate: memory and jsonl storage have no schema to migrate")
+	}
+
+	command := "up"
+	if len(args) > 0 {
+		command = args[0]
+	}
+
+	switch command {
+	case "up":
+		applied, err := a.migrateUp(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(out, "applied %d migration(s)\n", applied)
+		return err
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n < 1 {
+				return fmt.Errorf("migrate down: invalid step count %q", args[1])
+			}
+			steps = n
+		}
+		runner, err := a.migrationRunner()
+		if err != nil {
+			return err
+		}
+		reverted, err := runner.Down(ctx, steps)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(out, "reverted %d migration(s)\n", reverted)
+		return err
+	case "status":
+		runner, err := a.migrationRunner()
+		if err != nil {
+			return err
+		}
+		statuses, err := runner.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			state := "pending"
+			if status.Applied {
+				state = "applied " + status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			if _, err := fmt.Fprintf(out, "%03d_%s\t%s\n", status.Version, status.Name, state); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("migrate: unknown command %q (want up, down or status)", command)
+	}
+}
+
+func (a *Application) migrateUp(ctx context.Context) (int, error) {
+	runner, err := a.migrationRunner()
+	if err != nil {
+		return 0, err
+	}
+	applied, err := runner.Up(ctx)
+	if err != nil {
+		return applied, fmt.Errorf("migrate up: %w", err)
+	}
+	return applied, nil
+}
+
+func (a *Application) migrationRunner() (*migrate.Runner, error) {
+	return migrate.New(a.database.db, a.database.migrations, a.database.dialect, a.logger.With("component", "migrate"))
+}
@@ -0,0 +1,88 @@
+package app
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"io/fs"
+	"strings"
+
+	"ArticlesScanner/internal/config"
+	"ArticlesScanner/internal/infrastructure/storage"
+	"ArticlesScanner/internal/infrastructure/storage/memory"
+	"ArticlesScanner/internal/infrastructure/storage/migrate"
+	"ArticlesScanner/internal/infrastructure/storage/sqlite"
+	"ArticlesScanner/internal/ports"
+	"ArticlesScanner/migrations"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+// repository is the full set of storage ports every backend provides.
+type repository interface {
+	ports.ArticleRepository
+	ports.VersionTracker
+	ports.EnrichmentCache
+	ports.ScanOutcomeStore
+	ports.ArticleArchive
+	ports.RunStore
+}
+
+// database is an open storage backend together with the migrations written for its dialect;
+// db is nil for the memory and jsonl backends, which have no schema.
+type database struct {
+	db         *sql.DB
+	repo       repository
+	migrations fs.FS
+	dialect    migrate.Dialect
+}
+
+// openDatabase picks the backend by DSN scheme and returns nil when no DSN is configured. The SQL
+// backends connect lazily on first use; the jsonl backend reads and compacts its file right away.
+func openDatabase(cfg config.DatabaseConfig) (*database, error) {
+	backend, err := cfg.Backend()
+	if err != nil {
+		return nil, err
+	}
+
+	switch backend {
+	case config.DatabasePostgres:
+		db, err := sql.Open("pgx", cfg.DSN)
+		if err != nil {
+			return nil, fmt.Errorf("open database: %w", err)
+		}
+		return &database{db: db, repo: storage.NewPostgresRepository(db), migrations: migrations.Postgres, dialect: migrate.Postgres}, nil
+	case config.DatabaseSQLite:
+		db, err := sqlite.Open(cfg.DSN)
+		if err != nil {
+			return nil, err
+		}
+		return &database{db: db, repo: sqlite.NewRepository(db), migrations: migrations.SQLite, dialect: migrate.SQLite}, nil
+	case config.DatabaseMemory:
+		return &database{repo: memory.New()}, nil
+	case config.DatabaseJSONL:
+		path := strings.TrimPrefix(strings.TrimPrefix(cfg.DSN, "jsonl:"), "//")
+		if path == "" {
+			return nil, fmt.Errorf("open database: jsonl DSN %q has no file path", cfg.DSN)
+		}
+		repo, err := memory.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		return &database{repo: repo}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func (d *database) close() error {
+	if closer, ok := d.repo.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			return err
+		}
+	}
+	if d.db == nil {
+		return nil
+	}
+	return d.db.Close()
+}
@@ -4,12 +4,61 @@ import "time"
 
 // Article is a core entity describing metadata fetched from providers.
 type Article struct {
-	ID          string
-	Title       string
-	Abstract    string
-	URL         string
-	Source      string
-	PublishedAt time.Time
+	ID                  string
+	Title               string
+	Abstract            string
+	URL                 string
+	Source              string
+	Authors             []string
+	PrimaryCategory     string
+	SecondaryCategories []string
+	Comments            string
+	JournalRef          string
+	Announcement        AnnouncementType
+	Version             int
+	DOI                 string
+	Venue               string
+	Keywords            []string
+	PDFURL              string
+	PublishedAt         time.Time
+	Enrichment          *Enrichment
+}
+
+// AnnouncementType tells how a listing announced the article.
+type AnnouncementType string
+
+const (
+	AnnouncementNew         AnnouncementType = "new"
+	AnnouncementCrossList   AnnouncementType = "cross-list"
+	AnnouncementReplacement AnnouncementType = "replacement"
+)
+
+// AuthorMetrics carries per-author bibliometrics reported by enrichment providers.
+type AuthorMetrics struct {
+	Name   string `json:"name"`
+	HIndex int    `json:"hIndex"`
+}
+
+// Enrichment holds bibliometric metadata looked up before ranking.
+type Enrichment struct {
+	Provider      string          `json:"provider"`
+	CitationCount int             `json:"citationCount"`
+	Venue         string          `json:"venue,omitempty"`
+	FieldsOfStudy []string        `json:"fieldsOfStudy,omitempty"`
+	Authors       []AuthorMetrics `json:"authors,omitempty"`
+	OpenAccessPDF string          `json:"openAccessPdf,omitempty"`
+	FetchedAt     time.Time       `json:"fetchedAt"`
+}
+
+// MaxAuthorHIndex returns the highest h-index among enriched authors.
+func (e Enrichment) MaxAuthorHIndex() int {
+	maxIndex := 0
+	for _, author := range e.Authors {
+		if author.HIndex > maxIndex {
+			maxIndex = author.HIndex
+		}
+	}
+	return maxIndex
 }
 
 // ArticleReview captures ML scoring and enrichment for prioritization.
@@ -18,6 +67,7 @@ type ArticleReview struct {
 	Score     float64
 	Topics    []string
 	Summary   string
+	Model     string
 	RankedAt  time.Time
 	Processed bool
 }
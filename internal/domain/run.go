@@ -0,0 +1,55 @@
+package domain
+
+import "time"
+
+// RunStatus tells whether a pipeline run is still in progress or how it ended.
+type RunStatus string
+
+const (
+	RunRunning   RunStatus = "running"
+	RunSucceeded RunStatus = "succeeded"
+	RunFailed    RunStatus = "failed"
+)
+
+// PipelineRun records one execution of the daily pipeline and how far it got.
+type PipelineRun struct {
+	ID         int64
+	Day        time.Time
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Status     RunStatus
+	Fetched    int
+	Ranked     int
+	Summarized int
+	Delivered  int
+	Errors     []string
+}
+
+// Finished reports whether the run completed successfully; failed and interrupted runs are resumable.
+func (r PipelineRun) Finished() bool {
+	return r.Status == RunSucceeded
+}
+
+// Checkpoint is the last stage an article reached within a run, with the results computed so far
+// so a resumed run neither repeats ranking and summarization nor resends the ChatGPT digest.
+type Checkpoint struct {
+	RunID      int64
+	Position   int
+	Stage      ProcessingStatus
+	Review     ArticleReview
+	SentToChat bool
+	UpdatedAt  time.Time
+}
+
+// stageOrder ranks processing statuses along the pipeline.
+var stageOrder = map[ProcessingStatus]int{
+	StatusFetched:    1,
+	StatusRanked:     2,
+	StatusSummarized: 3,
+	StatusDelivered:  4,
+}
+
+// Reached reports whether s is at or past target in the pipeline.
+func (s ProcessingStatus) Reached(target ProcessingStatus) bool {
+	return stageOrder[s] >= stageOrder[target]
+}
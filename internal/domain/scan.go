@@ -0,0 +1,47 @@
+package domain
+
+import "time"
+
+// ScanOutcome records how one site, or one category of it, fared during a daily fetch.
+// Category is empty for the site-level outcome.
+type ScanOutcome struct {
+	Day       time.Time
+	Site      string
+	Category  string
+	Articles  int
+	Error     string
+	ScannedAt time.Time
+}
+
+// Failed reports whether the source produced an error.
+func (o ScanOutcome) Failed() bool {
+	return o.Error != ""
+}
+
+// ScanReport lists the outcomes of one FetchDaily run.
+type ScanReport struct {
+	Day      time.Time
+	Outcomes []ScanOutcome
+}
+
+// Failures returns the failed outcomes in report order.
+func (r ScanReport) Failures() []ScanOutcome {
+	var failed []ScanOutcome
+	for _, outcome := range r.Outcomes {
+		if outcome.Failed() {
+			failed = append(failed, outcome)
+		}
+	}
+	return failed
+}
+
+// FailedSites counts sites whose site-level outcome failed.
+func (r ScanReport) FailedSites() int {
+	count := 0
+	for _, outcome := range r.Outcomes {
+		if outcome.Category == "" && outcome.Failed() {
+			count++
+		}
+	}
+	return count
+}
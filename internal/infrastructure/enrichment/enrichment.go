@@ -0,0 +1,204 @@
+package enrichment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/ports"
+)
+
+var (
+	arxivVersionExpr = regexp.MustCompile(`v\d+$`)
+	arxivDOIPrefix   = "10.48550/arxiv."
+)
+
+// Chain queries providers in order and merges their answers, keeping the first non-empty value per field.
+type Chain struct {
+	providers []ports.Enricher
+	logger    *slog.Logger
+}
+
+var _ ports.Enricher = (*Chain)(nil)
+
+// NewChain combines providers; nil entries are ignored.
+func NewChain(log *slog.Logger, providers ...ports.Enricher) *Chain {
+	chain := &Chain{logger: log}
+	for _, provider := range providers {
+		if provider != nil {
+			chain.providers = append(chain.providers, provider)
+		}
+	}
+	return chain
+}
+
+// Enrich asks every provider; a failing provider is logged and skipped so the others still contribute.
+func (c *Chain) Enrich(ctx context.Context, article domain.Article) (*domain.Enrichment, error) {
+	var (
+		merged  *domain.Enrichment
+		lastErr error
+	)
+	for _, provider := range c.providers {
+		result, err := provider.Enrich(ctx, article)
+		if err != nil {
+			lastErr = err
+			if c.logger != nil {
+				c.logger.Warn("enrichment provider failed", "article_id", article.ID, "error", err)
+			}
+			continue
+		}
+		if result == nil {
+			continue
+		}
+		if merged == nil {
+			copied := *result
+			merged = &copied
+			continue
+		}
+		mergeEnrichment(merged, *result)
+	}
+
+	if merged == nil && lastErr != nil {
+		return nil, lastErr
+	}
+	return merged, nil
+}
+
+func mergeEnrichment(base *domain.Enrichment, extra domain.Enrichment) {
+	base.Provider = base.Provider + "," + extra.Provider
+	if extra.CitationCount > base.CitationCount {
+		base.CitationCount = extra.CitationCount
+	}
+	if base.Venue == "" {
+		base.Venue = extra.Venue
+	}
+	if len(base.FieldsOfStudy) == 0 {
+		base.FieldsOfStudy = extra.FieldsOfStudy
+	}
+	if base.MaxAuthorHIndex() == 0 && extra.MaxAuthorHIndex() > 0 {
+		base.Authors = extra.Authors
+	}
+	if base.OpenAccessPDF == "" {
+		base.OpenAccessPDF = extra.OpenAccessPDF
+	}
+}
+
+// Cached serves lookups from a persistent cache while entries are younger than ttl.
+type Cached struct {
+	inner ports.Enricher
+	cache ports.EnrichmentCache
+	ttl   time.Duration
+	now   func() time.Time
+}
+
+var _ ports.Enricher = (*Cached)(nil)
+
+// NewCached decorates inner with cache; a nil cache disables caching, a zero ttl never expires entries.
+func NewCached(inner ports.Enricher, cache ports.EnrichmentCache, ttl time.Duration) *Cached {
+	return &Cached{inner: inner, cache: cache, ttl: ttl, now: time.Now}
+}
+
+// Enrich returns a fresh cached entry or queries the inner provider and stores its answer.
+func (c *Cached) Enrich(ctx context.Context, article domain.Article) (*domain.Enrichment, error) {
+	if c.cache != nil {
+		cached, err := c.cache.LoadEnrichment(ctx, article.ID)
+		if err != nil {
+			return nil, fmt.Errorf("load cached enrichment: %w", err)
+		}
+		if cached != nil && (c.ttl <= 0 || c.now().Sub(cached.FetchedAt) < c.ttl) {
+			return cached, nil
+		}
+	}
+
+	result, err := c.inner.Enrich(ctx, article)
+	if err != nil || result == nil {
+		return result, err
+	}
+	if result.FetchedAt.IsZero() {
+		result.FetchedAt = c.now().UTC()
+	}
+
+	if c.cache != nil {
+		if err := c.cache.SaveEnrichment(ctx, article.ID, *result); err != nil {
+			return nil, fmt.Errorf("save enrichment: %w", err)
+		}
+	}
+	return result, nil
+}
+
+// articleKeys derives the lookup identifiers providers understand from scanner output.
+func articleKeys(article domain.Article) (arxivID, doi string) {
+	doi = strings.TrimSpace(article.DOI)
+	id := strings.TrimSpace(article.ID)
+
+	switch {
+	case strings.HasPrefix(id, "arXiv:"):
+		arxivID = arxivVersionExpr.ReplaceAllString(strings.TrimPrefix(id, "arXiv:"), "")
+	case strings.HasPrefix(id, "doi:") && doi == "":
+		doi = strings.TrimPrefix(id, "doi:")
+	}
+
+	if arxivID == "" && strings.HasPrefix(strings.ToLower(doi), arxivDOIPrefix) {
+		arxivID = doi[len(arxivDOIPrefix):]
+	}
+	return arxivID, doi
+}
+
+// getJSON decodes a 200 response into v; a 404 reports found=false without error.
+func getJSON(ctx context.Context, client *http.Client, target string, header http.Header, v any) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return false, fmt.Errorf("new request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("do request: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		if err := resp.Body.Close(); err != nil {
+			return false, fmt.Errorf("close response body: %w", err)
+		}
+		return false, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		payload, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		closeErr := resp.Body.Close()
+		if closeErr != nil {
+			return false, fmt.Errorf("unexpected status %s: %s, close body: %v", resp.Status, strings.TrimSpace(string(payload)), closeErr)
+		}
+		return false, fmt.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(payload)))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		_ = resp.Body.Close()
+		return false, fmt.Errorf("decode response: %w", err)
+	}
+
+	if err := resp.Body.Close(); err != nil {
+		return false, fmt.Errorf("close response body: %w", err)
+	}
+
+	return true, nil
+}
+
+// escapeIDPath escapes an identifier for a URL path while keeping DOI slashes readable, as both APIs expect.
+func escapeIDPath(id string) string {
+	return strings.ReplaceAll(url.PathEscape(id), "%2F", "/")
+}
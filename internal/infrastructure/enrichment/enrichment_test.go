@@ -0,0 +1,116 @@
+package enrichment
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"ArticlesScanner/internal/domain"
+)
+
+type stubEnricher struct {
+	result *domain.Enrichment
+	err    error
+	calls  int
+}
+
+func (s *stubEnricher) Enrich(context.Context, domain.Article) (*domain.Enrichment, error) {
+	s.calls++
+	return s.result, s.err
+}
+
+type memoryCache map[string]domain.Enrichment
+
+func (m memoryCache) LoadEnrichment(_ context.Context, id string) (*domain.Enrichment, error) {
+	if e, ok := m[id]; ok {
+		return &e, nil
+	}
+	return nil, nil
+}
+
+func (m memoryCache) SaveEnrichment(_ context.Context, id string, e domain.Enrichment) error {
+	m[id] = e
+	return nil
+}
+
+func TestArticleKeys(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		article domain.Article
+		arxiv   string
+		doi     string
+	}{
+		{domain.Article{ID: "arXiv:2511.00001v3"}, "2511.00001", ""},
+		{domain.Article{ID: "doi:10.1/abc"}, "", "10.1/abc"},
+		{domain.Article{ID: "pmid:1", DOI: "10.2/xyz"}, "", "10.2/xyz"},
+		{domain.Article{ID: "x", DOI: "10.48550/arXiv.2401.00002"}, "2401.00002", "10.48550/arXiv.2401.00002"},
+	}
+	for _, tc := range cases {
+		arxiv, doi := articleKeys(tc.article)
+		if arxiv != tc.arxiv || doi != tc.doi {
+			t.Fatalf("articleKeys(%+v) = %q, %q", tc.article, arxiv, doi)
+		}
+	}
+}
+
+func TestChainMergesProviders(t *testing.T) {
+	t.Parallel()
+
+	first := &stubEnricher{result: &domain.Enrichment{Provider: "a", CitationCount: 3, Venue: "ICML"}}
+	failing := &stubEnricher{err: errors.New("boom")}
+	second := &stubEnricher{result: &domain.Enrichment{
+		Provider:      "b",
+		CitationCount: 5,
+		Venue:         "Other",
+		Authors:       []domain.AuthorMetrics{{Name: "Ann", HIndex: 9}},
+		OpenAccessPDF: "pdf",
+	}}
+
+	result, err := NewChain(nil, first, failing, nil, second).Enrich(context.Background(), domain.Article{ID: "a"})
+	if err != nil {
+		t.Fatalf("Enrich error: %v", err)
+	}
+	if result.Provider != "a,b" || result.CitationCount != 5 || result.Venue != "ICML" {
+		t.Fatalf("unexpected merge: %+v", result)
+	}
+	if result.MaxAuthorHIndex() != 9 || result.OpenAccessPDF != "pdf" {
+		t.Fatalf("expected fields from second provider: %+v", result)
+	}
+
+	if _, err := NewChain(nil, failing).Enrich(context.Background(), domain.Article{ID: "a"}); err == nil {
+		t.Fatal("expected error when every provider fails")
+	}
+}
+
+func TestCachedRespectsTTL(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.November, 8, 12, 0, 0, 0, time.UTC)
+	inner := &stubEnricher{result: &domain.Enrichment{Provider: "a", CitationCount: 1}}
+	cache := memoryCache{}
+
+	cached := NewCached(inner, cache, 24*time.Hour)
+	cached.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if _, err := cached.Enrich(context.Background(), domain.Article{ID: "x"}); err != nil {
+			t.Fatalf("Enrich error: %v", err)
+		}
+	}
+	if inner.calls != 1 {
+		t.Fatalf("expected cache hit, inner called %d times", inner.calls)
+	}
+	if !cache["x"].FetchedAt.Equal(now) {
+		t.Fatalf("expected fetched timestamp to be stamped, got %v", cache["x"].FetchedAt)
+	}
+
+	cached.now = func() time.Time { return now.Add(25 * time.Hour) }
+	if _, err := cached.Enrich(context.Background(), domain.Article{ID: "x"}); err != nil {
+		t.Fatalf("Enrich error: %v", err)
+	}
+	if inner.calls != 2 {
+		t.Fatalf("expected refresh after ttl, inner called %d times", inner.calls)
+	}
+}
@@ -0,0 +1,165 @@
+package enrichment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"ArticlesScanner/internal/config"
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/ports"
+)
+
+const (
+	openAlexEndpoint    = "https://api.openalex.org"
+	openAlexWorkFields  = "id,cited_by_count,primary_location,topics,open_access,authorships"
+	openAlexAuthorLimit = 50
+)
+
+// OpenAlex looks up works in the OpenAlex API by DOI (arXiv papers via their 10.48550 DOI).
+type OpenAlex struct {
+	endpoint string
+	email    string
+	client   *http.Client
+}
+
+var _ ports.Enricher = (*OpenAlex)(nil)
+
+// NewOpenAlex builds a client from configuration; email opts into the polite pool.
+func NewOpenAlex(cfg config.OpenAlexConfig, client *http.Client) *OpenAlex {
+	if client == nil {
+		client = &http.Client{Timeout: 15 * time.Second}
+	}
+	endpoint := strings.TrimSuffix(cfg.Endpoint, "/")
+	if endpoint == "" {
+		endpoint = openAlexEndpoint
+	}
+	return &OpenAlex{endpoint: endpoint, email: cfg.Email, client: client}
+}
+
+// Enrich fetches the work and then its authors' summary stats for h-index.
+func (o *OpenAlex) Enrich(ctx context.Context, article domain.Article) (*domain.Enrichment, error) {
+	arxivID, doi := articleKeys(article)
+	if doi == "" && arxivID != "" {
+		doi = "10.48550/arXiv." + arxivID
+	}
+	if doi == "" {
+		return nil, nil
+	}
+
+	query := o.baseQuery()
+	query.Set("select", openAlexWorkFields)
+	target := fmt.Sprintf("%s/works/doi:%s?%s", o.endpoint, escapeIDPath(doi), query.Encode())
+
+	var work struct {
+		CitedByCount    int `json:"cited_by_count"`
+		PrimaryLocation *struct {
+			Source *struct {
+				DisplayName string `json:"display_name"`
+			} `json:"source"`
+		} `json:"primary_location"`
+		Topics []struct {
+			Field struct {
+				DisplayName string `json:"display_name"`
+			} `json:"field"`
+		} `json:"topics"`
+		OpenAccess struct {
+			OAURL string `json:"oa_url"`
+		} `json:"open_access"`
+		Authorships []struct {
+			Author struct {
+				ID          string `json:"id"`
+				DisplayName string `json:"display_name"`
+			} `json:"author"`
+		} `json:"authorships"`
+	}
+
+	found, err := getJSON(ctx, o.client, target, nil, &work)
+	if err != nil {
+		return nil, fmt.Errorf("openalex doi %s: %w", doi, err)
+	}
+	if !found {
+		return nil, nil
+	}
+
+	result := &domain.Enrichment{
+		Provider:      "openalex",
+		CitationCount: work.CitedByCount,
+		OpenAccessPDF: work.OpenAccess.OAURL,
+		FetchedAt:     time.Now().UTC(),
+	}
+	if work.PrimaryLocation != nil && work.PrimaryLocation.Source != nil {
+		result.Venue = work.PrimaryLocation.Source.DisplayName
+	}
+
+	seenFields := map[string]bool{}
+	for _, topic := range work.Topics {
+		field := topic.Field.DisplayName
+		if field != "" && !seenFields[field] {
+			seenFields[field] = true
+			result.FieldsOfStudy = append(result.FieldsOfStudy, field)
+		}
+	}
+
+	ids := make([]string, 0, len(work.Authorships))
+	for _, authorship := range work.Authorships {
+		result.Authors = append(result.Authors, domain.AuthorMetrics{Name: authorship.Author.DisplayName})
+		if id := openAlexShortID(authorship.Author.ID); id != "" && len(ids) < openAlexAuthorLimit {
+			ids = append(ids, id)
+		}
+	}
+
+	if len(ids) > 0 {
+		hIndexes, err := o.authorHIndexes(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for i, authorship := range work.Authorships {
+			result.Authors[i].HIndex = hIndexes[openAlexShortID(authorship.Author.ID)]
+		}
+	}
+
+	return result, nil
+}
+
+func (o *OpenAlex) authorHIndexes(ctx context.Context, ids []string) (map[string]int, error) {
+	query := o.baseQuery()
+	query.Set("filter", "ids.openalex:"+strings.Join(ids, "|"))
+	query.Set("select", "id,summary_stats")
+	query.Set("per-page", fmt.Sprint(openAlexAuthorLimit))
+	target := fmt.Sprintf("%s/authors?%s", o.endpoint, query.Encode())
+
+	var resp struct {
+		Results []struct {
+			ID           string `json:"id"`
+			SummaryStats struct {
+				HIndex int `json:"h_index"`
+			} `json:"summary_stats"`
+		} `json:"results"`
+	}
+	if _, err := getJSON(ctx, o.client, target, nil, &resp); err != nil {
+		return nil, fmt.Errorf("openalex authors: %w", err)
+	}
+
+	result := make(map[string]int, len(resp.Results))
+	for _, author := range resp.Results {
+		result[openAlexShortID(author.ID)] = author.SummaryStats.HIndex
+	}
+	return result, nil
+}
+
+func (o *OpenAlex) baseQuery() url.Values {
+	query := url.Values{}
+	if o.email != "" {
+		query.Set("mailto", o.email)
+	}
+	return query
+}
+
+// openAlexShortID turns https://openalex.org/A123 into A123.
+func openAlexShortID(id string) string {
+	return id[strings.LastIndex(id, "/")+1:]
+}
@@ -0,0 +1,59 @@
+package enrichment
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"ArticlesScanner/internal/config"
+	"ArticlesScanner/internal/domain"
+)
+
+func TestOpenAlexEnrich(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("mailto") != "ops@example.org" {
+			t.Errorf("missing mailto: %s", r.URL.RawQuery)
+		}
+		switch r.URL.Path {
+		case "/works/doi:10.1038/nature001":
+			_, _ = w.Write([]byte(`{
+			  "cited_by_count":12,
+			  "primary_location":{"source":{"display_name":"Nature"}},
+			  "topics":[{"field":{"display_name":"Physics"}},{"field":{"display_name":"Physics"}}],
+			  "open_access":{"oa_url":"https://oa.example/nature001.pdf"},
+			  "authorships":[{"author":{"id":"https://openalex.org/A1","display_name":"Ann"}},{"author":{"id":"https://openalex.org/A2","display_name":"Bob"}}]}`))
+		case "/authors":
+			if r.URL.Query().Get("filter") != "ids.openalex:A1|A2" {
+				t.Errorf("unexpected author filter: %s", r.URL.Query().Get("filter"))
+			}
+			_, _ = w.Write([]byte(`{"results":[{"id":"https://openalex.org/A2","summary_stats":{"h_index":19}},{"id":"https://openalex.org/A1","summary_stats":{"h_index":4}}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewOpenAlex(config.OpenAlexConfig{Endpoint: server.URL, Email: "ops@example.org"}, server.Client())
+
+	result, err := client.Enrich(context.Background(), domain.Article{ID: "doi:10.1038/nature001"})
+	if err != nil {
+		t.Fatalf("Enrich error: %v", err)
+	}
+	if result == nil || result.CitationCount != 12 || result.Venue != "Nature" {
+		t.Fatalf("unexpected enrichment: %+v", result)
+	}
+	if len(result.FieldsOfStudy) != 1 || result.FieldsOfStudy[0] != "Physics" {
+		t.Fatalf("unexpected fields: %v", result.FieldsOfStudy)
+	}
+	if len(result.Authors) != 2 || result.Authors[0].HIndex != 4 || result.Authors[1].HIndex != 19 {
+		t.Fatalf("unexpected author metrics: %+v", result.Authors)
+	}
+
+	missing, err := client.Enrich(context.Background(), domain.Article{ID: "arXiv:2511.00001"})
+	if err != nil || missing != nil {
+		t.Fatalf("expected nil for unknown arXiv DOI, got %+v, %v", missing, err)
+	}
+}
@@ -0,0 +1,96 @@
+package enrichment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"ArticlesScanner/internal/config"
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/ports"
+)
+
+const (
+	semanticScholarEndpoint = "https://api.semanticscholar.org/graph/v1"
+	semanticScholarFields   = "venue,citationCount,fieldsOfStudy,openAccessPdf,authors.name,authors.hIndex"
+)
+
+// SemanticScholar looks up papers in the Semantic Scholar Graph API by arXiv ID or DOI.
+type SemanticScholar struct {
+	endpoint string
+	apiKey   string
+	client   *http.Client
+}
+
+var _ ports.Enricher = (*SemanticScholar)(nil)
+
+// NewSemanticScholar builds a client from configuration; the API key is optional.
+func NewSemanticScholar(cfg config.SemanticScholarConfig, client *http.Client) *SemanticScholar {
+	if client == nil {
+		client = &http.Client{Timeout: 15 * time.Second}
+	}
+	endpoint := strings.TrimSuffix(cfg.Endpoint, "/")
+	if endpoint == "" {
+		endpoint = semanticScholarEndpoint
+	}
+	return &SemanticScholar{endpoint: endpoint, apiKey: cfg.APIKey, client: client}
+}
+
+// Enrich fetches citation count, venue, fields of study, author h-index and the open-access PDF.
+func (s *SemanticScholar) Enrich(ctx context.Context, article domain.Article) (*domain.Enrichment, error) {
+	arxivID, doi := articleKeys(article)
+
+	var paperID string
+	switch {
+	case arxivID != "":
+		paperID = "ARXIV:" + arxivID
+	case doi != "":
+		paperID = "DOI:" + doi
+	default:
+		return nil, nil
+	}
+
+	target := fmt.Sprintf("%s/paper/%s?fields=%s", s.endpoint, escapeIDPath(paperID), semanticScholarFields)
+	header := http.Header{}
+	if s.apiKey != "" {
+		header.Set("x-api-key", s.apiKey)
+	}
+
+	var paper struct {
+		Venue         string   `json:"venue"`
+		CitationCount int      `json:"citationCount"`
+		FieldsOfStudy []string `json:"fieldsOfStudy"`
+		OpenAccessPDF *struct {
+			URL string `json:"url"`
+		} `json:"openAccessPdf"`
+		Authors []struct {
+			Name   string `json:"name"`
+			HIndex int    `json:"hIndex"`
+		} `json:"authors"`
+	}
+
+	found, err := getJSON(ctx, s.client, target, header, &paper)
+	if err != nil {
+		return nil, fmt.Errorf("semantic scholar %s: %w", paperID, err)
+	}
+	if !found {
+		return nil, nil
+	}
+
+	result := &domain.Enrichment{
+		Provider:      "semanticscholar",
+		CitationCount: paper.CitationCount,
+		Venue:         strings.TrimSpace(paper.Venue),
+		FieldsOfStudy: paper.FieldsOfStudy,
+		FetchedAt:     time.Now().UTC(),
+	}
+	if paper.OpenAccessPDF != nil {
+		result.OpenAccessPDF = paper.OpenAccessPDF.URL
+	}
+	for _, author := range paper.Authors {
+		result.Authors = append(result.Authors, domain.AuthorMetrics{Name: author.Name, HIndex: author.HIndex})
+	}
+	return result, nil
+}
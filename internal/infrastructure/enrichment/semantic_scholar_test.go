@@ -0,0 +1,57 @@
+package enrichment
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"ArticlesScanner/internal/config"
+	"ArticlesScanner/internal/domain"
+)
+
+func TestSemanticScholarEnrich(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-api-key") != "key" {
+			t.Errorf("missing api key header")
+		}
+		switch r.URL.Path {
+		case "/graph/v1/paper/ARXIV:2511.00001":
+			if r.URL.Query().Get("fields") != semanticScholarFields {
+				t.Errorf("unexpected fields: %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{
+			  "venue":"NeurIPS","citationCount":42,"fieldsOfStudy":["Computer Science"],
+			  "openAccessPdf":{"url":"https://arxiv.org/pdf/2511.00001"},
+			  "authors":[{"name":"Ann","hIndex":7},{"name":"Bob","hIndex":31}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewSemanticScholar(config.SemanticScholarConfig{Endpoint: server.URL + "/graph/v1/", APIKey: "key"}, server.Client())
+
+	result, err := client.Enrich(context.Background(), domain.Article{ID: "arXiv:2511.00001v2"})
+	if err != nil {
+		t.Fatalf("Enrich error: %v", err)
+	}
+	if result == nil || result.CitationCount != 42 || result.Venue != "NeurIPS" {
+		t.Fatalf("unexpected enrichment: %+v", result)
+	}
+	if result.MaxAuthorHIndex() != 31 || result.OpenAccessPDF != "https://arxiv.org/pdf/2511.00001" {
+		t.Fatalf("unexpected authors/pdf: %+v", result)
+	}
+
+	missing, err := client.Enrich(context.Background(), domain.Article{ID: "x", DOI: "10.1/unknown"})
+	if err != nil || missing != nil {
+		t.Fatalf("expected nil for unknown paper, got %+v, %v", missing, err)
+	}
+
+	none, err := client.Enrich(context.Background(), domain.Article{ID: "pmid:1"})
+	if err != nil || none != nil {
+		t.Fatalf("expected nil without identifiers, got %+v, %v", none, err)
+	}
+}
@@ -0,0 +1,262 @@
+// Package httpcache keeps scanner GET responses on disk and revalidates them with conditional requests.
+package httpcache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"ArticlesScanner/internal/config"
+)
+
+// Header values reported in X-Cache so callers and logs can tell where a response came from.
+const (
+	cacheHeader      = "X-Cache"
+	cacheHit         = "HIT"
+	cacheRevalidated = "REVALIDATED"
+	cacheMiss        = "MISS"
+)
+
+// ErrNotCached is returned in offline mode for URLs that were never stored.
+var ErrNotCached = errors.New("httpcache: response not cached")
+
+// Cache is an http.RoundTripper storing 200 responses to GET requests keyed by URL.
+type Cache struct {
+	dir     string
+	ttl     time.Duration
+	offline bool
+	next    http.RoundTripper
+	now     func() time.Time
+	logger  *slog.Logger
+}
+
+var _ http.RoundTripper = (*Cache)(nil)
+
+// entry is the JSON sidecar stored next to each cached body.
+type entry struct {
+	URL       string      `json:"url"`
+	Header    http.Header `json:"header"`
+	StoredAt  time.Time   `json:"storedAt"`
+	ExpiresAt time.Time   `json:"expiresAt"`
+}
+
+// New wraps next; ttl is the freshness used when the server sends no max-age.
+func New(cfg config.HTTPCacheConfig, next http.RoundTripper, log *slog.Logger) *Cache {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &Cache{
+		dir:     cfg.Dir,
+		ttl:     cfg.TTL,
+		offline: cfg.Offline,
+		next:    next,
+		now:     time.Now,
+		logger:  log,
+	}
+}
+
+// RoundTrip serves fresh entries from disk, revalidates stale ones and stores new 200 responses.
+func (c *Cache) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || strings.Contains(req.Header.Get("Cache-Control"), "no-store") {
+		return c.next.RoundTrip(req)
+	}
+
+	key := cacheKey(req.URL.String())
+	cached, body, err := c.load(key)
+	if err != nil {
+		c.debug("ignoring unreadable cache entry", "url", req.URL.String(), "error", err)
+		cached = nil
+	}
+
+	if c.offline {
+		if cached == nil {
+			return nil, fmt.Errorf("%w: %s", ErrNotCached, req.URL.String())
+		}
+		return c.response(req, cached, body, cacheHit), nil
+	}
+
+	if cached != nil && c.now().Before(cached.ExpiresAt) && !mustRevalidate(cached.Header) {
+		c.debug("cache hit", "url", req.URL.String())
+		return c.response(req, cached, body, cacheHit), nil
+	}
+
+	outgoing := req
+	if cached != nil {
+		outgoing = req.Clone(req.Context())
+		if etag := cached.Header.Get("ETag"); etag != "" {
+			outgoing.Header.Set("If-None-Match", etag)
+		}
+		if modified := cached.Header.Get("Last-Modified"); modified != "" {
+			outgoing.Header.Set("If-Modified-Since", modified)
+		}
+	}
+
+	resp, err := c.next.RoundTrip(outgoing)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		_ = resp.Body.Close()
+		for key, values := range resp.Header {
+			cached.Header[key] = values
+		}
+		cached.StoredAt = c.now()
+		cached.ExpiresAt = c.expiry(cached.Header)
+		if err := c.storeEntry(key, cached); err != nil {
+			c.debug("refresh cache entry failed", "url", req.URL.String(), "error", err)
+		}
+		c.debug("cache revalidated", "url", req.URL.String())
+		return c.response(req, cached, body, cacheRevalidated), nil
+	}
+
+	if resp.StatusCode != http.StatusOK || strings.Contains(resp.Header.Get("Cache-Control"), "no-store") {
+		return resp, nil
+	}
+
+	payload, err := io.ReadAll(resp.Body)
+	closeErr := resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("read response for cache: %w", err)
+	}
+	if closeErr != nil {
+		return nil, fmt.Errorf("close response for cache: %w", closeErr)
+	}
+
+	stored := &entry{URL: req.URL.String(), Header: resp.Header.Clone(), StoredAt: c.now()}
+	stored.ExpiresAt = c.expiry(stored.Header)
+	if err := c.store(key, stored, payload); err != nil {
+		c.debug("store cache entry failed", "url", req.URL.String(), "error", err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(payload))
+	resp.ContentLength = int64(len(payload))
+	resp.Header.Set(cacheHeader, cacheMiss)
+	return resp, nil
+}
+
+func (c *Cache) response(req *http.Request, cached *entry, body []byte, status string) *http.Response {
+	header := cached.Header.Clone()
+	header.Set(cacheHeader, status)
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// expiry prefers the server's max-age over the configured TTL.
+func (c *Cache) expiry(header http.Header) time.Time {
+	ttl := c.ttl
+	if maxAge, ok := maxAge(header.Get("Cache-Control")); ok {
+		ttl = maxAge
+	}
+	return c.now().Add(ttl)
+}
+
+func (c *Cache) load(key string) (*entry, []byte, error) {
+	raw, err := os.ReadFile(filepath.Join(c.dir, key+".json"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	var cached entry
+	if err := json.Unmarshal(raw, &cached); err != nil {
+		return nil, nil, fmt.Errorf("decode cache entry: %w", err)
+	}
+	if cached.Header == nil {
+		cached.Header = http.Header{}
+	}
+	body, err := os.ReadFile(filepath.Join(c.dir, key+".body"))
+	if err != nil {
+		return nil, nil, err
+	}
+	return &cached, body, nil
+}
+
+func (c *Cache) store(key string, cached *entry, body []byte) error {
+	if err := os.MkdirAll(c.dir, 0o755); err != nil {
+		return fmt.Errorf("create cache dir: %w", err)
+	}
+	if err := writeAtomic(filepath.Join(c.dir, key+".body"), body); err != nil {
+		return err
+	}
+	return c.storeEntry(key, cached)
+}
+
+func (c *Cache) storeEntry(key string, cached *entry) error {
+	raw, err := json.Marshal(cached)
+	if err != nil {
+		return fmt.Errorf("encode cache entry: %w", err)
+	}
+	return writeAtomic(filepath.Join(c.dir, key+".json"), raw)
+}
+
+// writeAtomic renames a temp file into place so concurrent readers never see partial entries.
+func writeAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("create temp cache file: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("write cache file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("close cache file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("rename cache file: %w", err)
+	}
+	return nil
+}
+
+func cacheKey(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return hex.EncodeToString(sum[:])
+}
+
+func maxAge(cacheControl string) (time.Duration, bool) {
+	for _, directive := range strings.Split(cacheControl, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(directive), "=")
+		if !ok || !strings.EqualFold(name, "max-age") {
+			continue
+		}
+		if seconds, err := strconv.Atoi(strings.Trim(value, `"`)); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+	}
+	return 0, false
+}
+
+func mustRevalidate(header http.Header) bool {
+	return strings.Contains(strings.ToLower(header.Get("Cache-Control")), "no-cache")
+}
+
+func (c *Cache) debug(msg string, args ...interface{}) {
+	if c.logger != nil {
+		c.logger.Debug(msg, args...)
+	}
+}
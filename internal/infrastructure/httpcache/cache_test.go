@@ -0,0 +1,114 @@
+package httpcache
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"ArticlesScanner/internal/config"
+)
+
+func TestCacheRevalidatesWithETag(t *testing.T) {
+	t.Parallel()
+
+	var full, conditional atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			conditional.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		full.Add(1)
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte("listing"))
+	}))
+	defer server.Close()
+
+	now := time.Date(2025, time.November, 10, 6, 0, 0, 0, time.UTC)
+	cache := New(config.HTTPCacheConfig{Dir: t.TempDir(), TTL: time.Hour}, nil, nil)
+	cache.now = func() time.Time { return now }
+	client := &http.Client{Transport: cache}
+
+	expect := func(status string) {
+		t.Helper()
+		resp, err := client.Get(server.URL + "/list/cs.AI")
+		if err != nil {
+			t.Fatalf("Get error: %v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if string(body) != "listing" || resp.Header.Get(cacheHeader) != status {
+			t.Fatalf("expected %s with body, got %s %q", status, resp.Header.Get(cacheHeader), body)
+		}
+	}
+
+	expect(cacheMiss)
+	expect(cacheHit)
+	now = now.Add(2 * time.Hour)
+	expect(cacheRevalidated)
+	expect(cacheHit)
+
+	if full.Load() != 1 || conditional.Load() != 1 {
+		t.Fatalf("expected 1 full and 1 conditional request, got %d and %d", full.Load(), conditional.Load())
+	}
+}
+
+func TestCacheHonoursMaxAge(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Cache-Control", "public, max-age=0")
+		_, _ = w.Write([]byte("fresh"))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: New(config.HTTPCacheConfig{Dir: t.TempDir(), TTL: time.Hour}, nil, nil)}
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("Get error: %v", err)
+		}
+		_ = resp.Body.Close()
+	}
+	if calls.Load() != 2 {
+		t.Fatalf("max-age=0 should bypass the TTL, got %d calls", calls.Load())
+	}
+}
+
+func TestCacheOffline(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("stored"))
+	}))
+	dir := t.TempDir()
+
+	online := &http.Client{Transport: New(config.HTTPCacheConfig{Dir: dir}, nil, nil)}
+	resp, err := online.Get(server.URL + "/page")
+	if err != nil {
+		t.Fatalf("Get error: %v", err)
+	}
+	_ = resp.Body.Close()
+	server.Close()
+
+	offline := &http.Client{Transport: New(config.HTTPCacheConfig{Dir: dir, Offline: true}, nil, nil)}
+	resp, err = offline.Get(server.URL + "/page")
+	if err != nil {
+		t.Fatalf("offline Get error: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if string(body) != "stored" {
+		t.Fatalf("unexpected offline body: %q", body)
+	}
+
+	if _, err := offline.Get(server.URL + "/other"); !errors.Is(err, ErrNotCached) {
+		t.Fatalf("expected ErrNotCached, got %v", err)
+	}
+}
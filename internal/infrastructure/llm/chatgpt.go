@@ -25,16 +25,17 @@ type ChatGPTClient struct {
 
 var _ ports.ChatClient = (*ChatGPTClient)(nil)
 
-// NewChatGPTClient builds a client from configuration.
-func NewChatGPTClient(cfg config.ChatGPTConfig) *ChatGPTClient {
+// NewChatGPTClient builds a client from configuration; a nil HTTP client falls back to a plain one.
+func NewChatGPTClient(cfg config.ChatGPTConfig, client *http.Client) *ChatGPTClient {
+	if client == nil {
+		client = &http.Client{Timeout: 20 * time.Second}
+	}
 	return &ChatGPTClient{
 		endpoint:     cfg.Endpoint,
 		model:        cfg.Model,
 		apiKey:       cfg.APIKey,
 		systemPrompt: cfg.SystemPrompt,
-		httpClient: &http.Client{
-			Timeout: 20 * time.Second,
-		},
+		httpClient:   client,
 	}
 }
 
@@ -16,36 +16,55 @@ import (
 type Client struct {
 	endpoint string
 	apiKey   string
+	model    string
 	http     *http.Client
 }
 
 var _ ports.Analyzer = (*Client)(nil)
 var _ ports.Summarizer = (*Client)(nil)
 
-// NewClient creates a reusable HTTP client.
-func NewClient(endpoint, apiKey string) *Client {
+// NewClient wires the service endpoint; model names the ranker in reviews when the service does not
+// return one and defaults to the endpoint. A nil client falls back to a plain one with a short timeout.
+func NewClient(endpoint, apiKey, model string, client *http.Client) *Client {
+	if client == nil {
+		client = &http.Client{Timeout: 15 * time.Second}
+	}
+	if model == "" {
+		model = endpoint
+	}
 	return &Client{
 		endpoint: endpoint,
 		apiKey:   apiKey,
-		http:     &http.Client{Timeout: 15 * time.Second},
+		model:    model,
+		http:     client,
 	}
 }
 
-// Rank sends the abstract for scoring and topic detection.
+// Rank sends the abstract for scoring and topic detection; the review records the model reported
+// by the service or, without one, the configured model.
 func (c *Client) Rank(ctx context.Context, article domain.Article) (domain.ArticleReview, error) {
 	if c.http == nil {
-		return domain.ArticleReview{Article: article}, nil
+		return domain.ArticleReview{Article: article, Model: c.model}, nil
 	}
 
 	payload := map[string]any{
 		"title":    article.Title,
 		"abstract": article.Abstract,
 	}
+	if enrichment := article.Enrichment; enrichment != nil {
+		payload["citations"] = enrichment.CitationCount
+		payload["venue"] = enrichment.Venue
+		payload["fieldsOfStudy"] = enrichment.FieldsOfStudy
+		payload["maxAuthorHIndex"] = enrichment.MaxAuthorHIndex()
+	}
 
 	review := domain.ArticleReview{Article: article}
 	if err := c.post(ctx, "/rank", payload, &review); err != nil {
 		return domain.ArticleReview{}, err
 	}
+	if review.Model == "" {
+		review.Model = c.model
+	}
 
 	return review, nil
 }
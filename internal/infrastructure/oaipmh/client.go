@@ -0,0 +1,270 @@
+// Package oaipmh implements the harvester side of the OAI-PMH 2.0 protocol.
+package oaipmh
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Error codes defined by the OAI-PMH specification.
+const (
+	CodeBadArgument             = "badArgument"
+	CodeBadResumptionToken      = "badResumptionToken"
+	CodeBadVerb                 = "badVerb"
+	CodeCannotDisseminateFormat = "cannotDisseminateFormat"
+	CodeIDDoesNotExist          = "idDoesNotExist"
+	CodeNoRecordsMatch          = "noRecordsMatch"
+	CodeNoMetadataFormats       = "noMetadataFormats"
+	CodeNoSetHierarchy          = "noSetHierarchy"
+)
+
+// maxPages guards against repositories that hand out resumption tokens forever.
+const maxPages = 10000
+
+// Error is a protocol-level error returned inside an OAI-PMH response.
+type Error struct {
+	Code    string `xml:"code,attr"`
+	Message string `xml:",chardata"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("oai error %s: %s", e.Code, strings.TrimSpace(e.Message))
+}
+
+// HasCode reports whether err carries the given OAI-PMH error code.
+func HasCode(err error, code string) bool {
+	var oaiErr *Error
+	return errors.As(err, &oaiErr) && oaiErr.Code == code
+}
+
+// Client talks to a single repository base URL.
+type Client struct {
+	baseURL string
+	client  *http.Client
+	logger  *slog.Logger
+}
+
+// NewClient wires an HTTP client; nil falls back to a client with a sane timeout.
+func NewClient(baseURL string, client *http.Client, log *slog.Logger) *Client {
+	if client == nil {
+		client = &http.Client{Timeout: 60 * time.Second}
+	}
+	return &Client{baseURL: strings.TrimSpace(baseURL), client: client, logger: log}
+}
+
+// Identify describes the repository.
+type Identify struct {
+	RepositoryName    string   `xml:"repositoryName"`
+	BaseURL           string   `xml:"baseURL"`
+	ProtocolVersion   string   `xml:"protocolVersion"`
+	AdminEmails       []string `xml:"adminEmail"`
+	EarliestDatestamp string   `xml:"earliestDatestamp"`
+	DeletedRecord     string   `xml:"deletedRecord"`
+	Granularity       string   `xml:"granularity"`
+}
+
+// Set is one node of the repository's set hierarchy.
+type Set struct {
+	Spec string `xml:"setSpec"`
+	Name string `xml:"setName"`
+}
+
+// Header carries record identity, datestamp and set membership.
+type Header struct {
+	Status     string   `xml:"status,attr"`
+	Identifier string   `xml:"identifier"`
+	Datestamp  string   `xml:"datestamp"`
+	SetSpecs   []string `xml:"setSpec"`
+}
+
+// Deleted reports whether the repository marked the record as withdrawn.
+func (h Header) Deleted() bool {
+	return h.Status == "deleted"
+}
+
+// Record is a harvested header plus its metadata in the requested format.
+type Record struct {
+	Header   Header   `xml:"header"`
+	Metadata Metadata `xml:"metadata"`
+}
+
+// Metadata keeps the raw payload so callers can decode any metadata format.
+type Metadata struct {
+	Inner []byte `xml:",innerxml"`
+}
+
+// Decode unmarshals the metadata children into v; v's fields name the format root, e.g. `xml:"dc"`.
+func (m Metadata) Decode(v any) error {
+	wrapped := make([]byte, 0, len(m.Inner)+len("<metadata></metadata>"))
+	wrapped = append(wrapped, "<metadata>"...)
+	wrapped = append(wrapped, m.Inner...)
+	wrapped = append(wrapped, "</metadata>"...)
+	if err := xml.Unmarshal(wrapped, v); err != nil {
+		return fmt.Errorf("decode metadata: %w", err)
+	}
+	return nil
+}
+
+// ListRecordsOptions selects records; From and Until are datestamps in the repository granularity.
+type ListRecordsOptions struct {
+	MetadataPrefix string
+	Set            string
+	From           string
+	Until          string
+}
+
+type response struct {
+	Error       *Error   `xml:"error"`
+	Identify    Identify `xml:"Identify"`
+	ListSets    listPage `xml:"ListSets"`
+	ListRecords listPage `xml:"ListRecords"`
+}
+
+type listPage struct {
+	Sets            []Set    `xml:"set"`
+	Records         []Record `xml:"record"`
+	ResumptionToken string   `xml:"resumptionToken"`
+}
+
+// Identify fetches the repository description.
+func (c *Client) Identify(ctx context.Context) (Identify, error) {
+	resp, err := c.do(ctx, url.Values{"verb": {"Identify"}})
+	if err != nil {
+		return Identify{}, fmt.Errorf("identify: %w", err)
+	}
+	return resp.Identify, nil
+}
+
+// ListSets walks the full set hierarchy, following resumption tokens.
+func (c *Client) ListSets(ctx context.Context) ([]Set, error) {
+	var sets []Set
+	err := c.paginate(ctx, url.Values{"verb": {"ListSets"}}, func(page response) error {
+		sets = append(sets, page.ListSets.Sets...)
+		return nil
+	}, func(page response) string {
+		return page.ListSets.ResumptionToken
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list sets: %w", err)
+	}
+	return sets, nil
+}
+
+// ListRecords streams every matching record to fn, following resumption tokens.
+// noRecordsMatch is not an error: fn is simply never called.
+func (c *Client) ListRecords(ctx context.Context, opts ListRecordsOptions, fn func(Record) error) error {
+	if opts.MetadataPrefix == "" {
+		return fmt.Errorf("list records: metadataPrefix is required")
+	}
+	query := url.Values{}
+	query.Set("verb", "ListRecords")
+	query.Set("metadataPrefix", opts.MetadataPrefix)
+	if opts.Set != "" {
+		query.Set("set", opts.Set)
+	}
+	if opts.From != "" {
+		query.Set("from", opts.From)
+	}
+	if opts.Until != "" {
+		query.Set("until", opts.Until)
+	}
+
+	err := c.paginate(ctx, query, func(page response) error {
+		for _, record := range page.ListRecords.Records {
+			if err := fn(record); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, func(page response) string {
+		return page.ListRecords.ResumptionToken
+	})
+	if HasCode(err, CodeNoRecordsMatch) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("list records: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) paginate(ctx context.Context, query url.Values, handle func(response) error, token func(response) string) error {
+	verb := query.Get("verb")
+	for range maxPages {
+		page, err := c.do(ctx, query)
+		if err != nil {
+			return err
+		}
+		if err := handle(page); err != nil {
+			return err
+		}
+
+		next := strings.TrimSpace(token(page))
+		if next == "" {
+			return nil
+		}
+		query = url.Values{}
+		query.Set("verb", verb)
+		query.Set("resumptionToken", next)
+	}
+	return fmt.Errorf("resumption tokens exceeded %d pages", maxPages)
+}
+
+func (c *Client) do(ctx context.Context, query url.Values) (response, error) {
+	parsed, err := url.Parse(c.baseURL)
+	if err != nil {
+		return response{}, fmt.Errorf("parse base url: %w", err)
+	}
+	parsed.RawQuery = query.Encode()
+	target := parsed.String()
+	c.debug("requesting", "url", target)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return response{}, fmt.Errorf("build request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return response{}, fmt.Errorf("request %s: %w", target, err)
+	}
+
+	body, readErr := io.ReadAll(resp.Body)
+	closeErr := resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		payload := body
+		if len(payload) > 512 {
+			payload = payload[:512]
+		}
+		return response{}, fmt.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(payload)))
+	}
+	if readErr != nil {
+		return response{}, fmt.Errorf("read body: %w", readErr)
+	}
+	if closeErr != nil {
+		return response{}, fmt.Errorf("close response body: %w", closeErr)
+	}
+
+	var page response
+	if err := xml.Unmarshal(body, &page); err != nil {
+		return response{}, fmt.Errorf("decode response: %w", err)
+	}
+	if page.Error != nil {
+		return response{}, page.Error
+	}
+	return page, nil
+}
+
+func (c *Client) debug(msg string, args ...interface{}) {
+	if c.logger != nil {
+		c.logger.Debug(msg, args...)
+	}
+}
@@ -0,0 +1,129 @@
+package oaipmh
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const envelope = `<?xml version="1.0" encoding="UTF-8"?><OAI-PMH xmlns="http://www.openarchives.org/OAI/2.0/">%s</OAI-PMH>`
+
+func oaiServer(t *testing.T, handler func(verb, token string) string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		_, _ = w.Write([]byte(fmt.Sprintf(envelope, handler(q.Get("verb"), q.Get("resumptionToken")))))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClientIdentifyAndListSets(t *testing.T) {
+	t.Parallel()
+
+	server := oaiServer(t, func(verb, token string) string {
+		switch {
+		case verb == "Identify":
+			return `<Identify><repositoryName>Demo</repositoryName><baseURL>http://demo/oai</baseURL><protocolVersion>2.0</protocolVersion>
+			  <adminEmail>a@demo</adminEmail><earliestDatestamp>2001-01-01</earliestDatestamp><deletedRecord>persistent</deletedRecord><granularity>YYYY-MM-DD</granularity></Identify>`
+		case verb == "ListSets" && token == "":
+			return `<ListSets><set><setSpec>math</setSpec><setName>Mathematics</setName></set><resumptionToken completeListSize="2">s2</resumptionToken></ListSets>`
+		case verb == "ListSets" && token == "s2":
+			return `<ListSets><set><setSpec>phys</setSpec><setName>Physics</setName></set><resumptionToken/></ListSets>`
+		}
+		t.Errorf("unexpected verb %s token %s", verb, token)
+		return `<error code="badVerb">bad</error>`
+	})
+
+	client := NewClient(server.URL, server.Client(), nil)
+	identify, err := client.Identify(context.Background())
+	if err != nil {
+		t.Fatalf("Identify error: %v", err)
+	}
+	if identify.RepositoryName != "Demo" || identify.Granularity != "YYYY-MM-DD" || len(identify.AdminEmails) != 1 {
+		t.Fatalf("unexpected identify: %+v", identify)
+	}
+
+	sets, err := client.ListSets(context.Background())
+	if err != nil {
+		t.Fatalf("ListSets error: %v", err)
+	}
+	if len(sets) != 2 || sets[1].Spec != "phys" || sets[0].Name != "Mathematics" {
+		t.Fatalf("unexpected sets: %+v", sets)
+	}
+}
+
+func TestClientListRecords(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		var body string
+		switch {
+		case q.Get("set") == "empty":
+			body = `<error code="noRecordsMatch">nothing</error>`
+		case q.Get("metadataPrefix") == "bogus":
+			body = `<error code="cannotDisseminateFormat">unknown format</error>`
+		case q.Get("resumptionToken") == "":
+			if q.Get("from") != "2025-11-08" || q.Get("until") != "2025-11-08" || q.Get("set") != "math" || q.Get("metadataPrefix") != "oai_dc" {
+				t.Errorf("unexpected query %s", r.URL.RawQuery)
+			}
+			body = `<ListRecords>
+			  <record><header><identifier>oai:demo:1</identifier><datestamp>2025-11-08</datestamp><setSpec>math</setSpec></header>
+			    <metadata><oai_dc:dc xmlns:oai_dc="http://www.openarchives.org/OAI/2.0/oai_dc/" xmlns:dc="http://purl.org/dc/elements/1.1/">
+			      <dc:title>First</dc:title><dc:creator>Lee, Ann</dc:creator><dc:creator>Bo Chen</dc:creator>
+			    </oai_dc:dc></metadata></record>
+			  <record><header status="deleted"><identifier>oai:demo:2</identifier><datestamp>2025-11-08</datestamp></header></record>
+			  <resumptionToken>page-2</resumptionToken>
+			</ListRecords>`
+		case q.Get("resumptionToken") == "page-2" && q.Get("verb") == "ListRecords" && q.Get("set") == "":
+			body = `<ListRecords><record><header><identifier>oai:demo:3</identifier><datestamp>2025-11-08</datestamp></header>
+			  <metadata><other xmlns="urn:x"><title>Not DC</title></other></metadata></record></ListRecords>`
+		default:
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		_, _ = w.Write([]byte(fmt.Sprintf(envelope, body)))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL+"/oai", server.Client(), nil)
+
+	var records []Record
+	err := client.ListRecords(context.Background(), ListRecordsOptions{MetadataPrefix: MetadataPrefixDC, Set: "math", From: "2025-11-08", Until: "2025-11-08"}, func(r Record) error {
+		records = append(records, r)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ListRecords error: %v", err)
+	}
+	if len(records) != 3 || !records[1].Header.Deleted() || records[0].Header.SetSpecs[0] != "math" {
+		t.Fatalf("unexpected records: %+v", records)
+	}
+
+	dc, ok, err := records[0].DublinCore()
+	if err != nil || !ok {
+		t.Fatalf("DublinCore: ok=%v err=%v", ok, err)
+	}
+	if First(dc.Title) != "First" || len(dc.Creator) != 2 {
+		t.Fatalf("unexpected dublin core: %+v", dc)
+	}
+	if _, ok, err := records[2].DublinCore(); ok || err != nil {
+		t.Fatalf("expected non-DC record to report ok=false, got ok=%v err=%v", ok, err)
+	}
+
+	called := false
+	err = client.ListRecords(context.Background(), ListRecordsOptions{MetadataPrefix: MetadataPrefixDC, Set: "empty"}, func(Record) error {
+		called = true
+		return nil
+	})
+	if err != nil || called {
+		t.Fatalf("noRecordsMatch should yield no records and no error: called=%v err=%v", called, err)
+	}
+
+	err = client.ListRecords(context.Background(), ListRecordsOptions{MetadataPrefix: "bogus"}, func(Record) error { return nil })
+	if !HasCode(err, CodeCannotDisseminateFormat) {
+		t.Fatalf("expected cannotDisseminateFormat, got %v", err)
+	}
+}
@@ -0,0 +1,49 @@
+package oaipmh
+
+import "strings"
+
+// MetadataPrefixDC is the unqualified Dublin Core format every repository must support.
+const MetadataPrefixDC = "oai_dc"
+
+// DublinCore holds the fifteen oai_dc elements; each may repeat.
+type DublinCore struct {
+	Title       []string `xml:"title"`
+	Creator     []string `xml:"creator"`
+	Subject     []string `xml:"subject"`
+	Description []string `xml:"description"`
+	Publisher   []string `xml:"publisher"`
+	Contributor []string `xml:"contributor"`
+	Date        []string `xml:"date"`
+	Type        []string `xml:"type"`
+	Format      []string `xml:"format"`
+	Identifier  []string `xml:"identifier"`
+	Source      []string `xml:"source"`
+	Language    []string `xml:"language"`
+	Relation    []string `xml:"relation"`
+	Coverage    []string `xml:"coverage"`
+	Rights      []string `xml:"rights"`
+}
+
+// DublinCore decodes an oai_dc payload; ok is false when the record carries another format or none.
+func (r Record) DublinCore() (DublinCore, bool, error) {
+	var payload struct {
+		DC *DublinCore `xml:"dc"`
+	}
+	if err := r.Metadata.Decode(&payload); err != nil {
+		return DublinCore{}, false, err
+	}
+	if payload.DC == nil {
+		return DublinCore{}, false, nil
+	}
+	return *payload.DC, true, nil
+}
+
+// First returns the first non-blank value with whitespace trimmed.
+func First(values []string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
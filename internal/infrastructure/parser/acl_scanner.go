@@ -0,0 +1,183 @@
+package parser
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/scanner"
+)
+
+const (
+	aclDataEndpoint = "https://raw.githubusercontent.com/acl-org/acl-anthology/master/data/xml"
+	aclSiteURL      = "https://aclanthology.org"
+)
+
+// aclEventExpr matches event-style names such as acl-2024 or emnlp-2023.
+var aclEventExpr = regexp.MustCompile(`^([a-z0-9]+)-(\d{4})$`)
+
+// ACLScanner reads ACL Anthology collection XML (one file per venue and year).
+type ACLScanner struct {
+	client *http.Client
+	logger *slog.Logger
+}
+
+// NewACLScanner wires an HTTP client; nil falls back to a client with a sane timeout.
+func NewACLScanner(client *http.Client, log *slog.Logger) *ACLScanner {
+	if client == nil {
+		client = &http.Client{Timeout: 60 * time.Second}
+	}
+	return &ACLScanner{client: client, logger: log}
+}
+
+// Name identifies the strategy inside the registry.
+func (a *ACLScanner) Name() string {
+	return "acl"
+}
+
+// Scan loads the collection for each category and returns its papers keyed by Anthology ID.
+//
+// The category name is an event (acl-2024) or collection ID (2024.acl); a category URL points at the XML
+// directly. Proceedings appear once, so every paper is returned and storage dedup suppresses repeats;
+// option "ingestDay" = "true" keeps only volumes ingested on the requested day. Option "volumes" limits
+// volume IDs (comma separated, e.g. long,short) and "endpoint" overrides the data location.
+func (a *ACLScanner) Scan(ctx context.Context, req scanner.Request) ([]domain.Article, error) {
+	if len(req.Categories) == 0 {
+		return nil, fmt.Errorf("no categories provided for site %s", req.SiteName)
+	}
+
+	endpoint := strings.TrimSuffix(optionOr(req.Options, "endpoint", aclDataEndpoint), "/")
+	onlyIngestDay := req.Options["ingestDay"] == "true"
+	volumes := map[string]bool{}
+	for _, v := range strings.Split(req.Options["volumes"], ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			volumes[v] = true
+		}
+	}
+
+	a.debug("scan start", "site", req.SiteName, "collections", len(req.Categories), "target_day", req.Day.Format("2006-01-02"))
+
+	results := make([]domain.Article, 0)
+	seen := map[string]struct{}{}
+	for _, cat := range req.Categories {
+		target := strings.TrimSpace(cat.URL)
+		if target == "" {
+			collectionID, err := aclCollectionID(cat.Name)
+			if err != nil {
+				return nil, fmt.Errorf("category %s: %w", cat.Name, err)
+			}
+			target = endpoint + "/" + collectionID + ".xml"
+		}
+		a.debug("requesting", "category", cat.Name, "url", target)
+
+		body, err := fetchBody(ctx, a.client, target, nil)
+		if err != nil {
+			return nil, fmt.Errorf("category %s: %w", cat.Name, err)
+		}
+
+		var collection aclCollection
+		if err := xml.Unmarshal(body, &collection); err != nil {
+			return nil, fmt.Errorf("category %s: decode collection: %w", cat.Name, err)
+		}
+
+		source := articleSource(req.SiteName, cat.Name)
+		for _, volume := range collection.Volumes {
+			if len(volumes) > 0 && !volumes[volume.ID] {
+				continue
+			}
+			ingested, _ := time.ParseInLocation("2006-01-02", volume.IngestDate, req.Day.Location())
+			if onlyIngestDay && !sameDay(ingested, req.Day) {
+				continue
+			}
+			for _, paper := range volume.Papers {
+				article := paper.toArticle(collection.ID, volume, ingested, source)
+				if _, ok := seen[article.ID]; ok {
+					continue
+				}
+				seen[article.ID] = struct{}{}
+				results = append(results, article)
+			}
+		}
+		a.debug("collection processed", "category", cat.Name, "collection", collection.ID, "volumes", len(collection.Volumes))
+	}
+
+	a.debug("scan finished", "site", req.SiteName, "total", len(results))
+	return results, nil
+}
+
+// aclCollectionID maps acl-2024 to 2024.acl and passes collection IDs through.
+func aclCollectionID(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if m := aclEventExpr.FindStringSubmatch(name); m != nil {
+		return m[2] + "." + m[1], nil
+	}
+	if strings.Contains(name, ".") {
+		return name, nil
+	}
+	return "", fmt.Errorf("expected event name like acl-2024 or collection id like 2024.acl, got %q", name)
+}
+
+type aclCollection struct {
+	ID      string      `xml:"id,attr"`
+	Volumes []aclVolume `xml:"volume"`
+}
+
+type aclVolume struct {
+	ID         string `xml:"id,attr"`
+	IngestDate string `xml:"ingest-date,attr"`
+	Meta       struct {
+		BookTitle innerXML `xml:"booktitle"`
+	} `xml:"meta"`
+	Papers []aclPaper `xml:"paper"`
+}
+
+type aclPaper struct {
+	ID       string   `xml:"id,attr"`
+	Title    innerXML `xml:"title"`
+	Abstract innerXML `xml:"abstract"`
+	Authors  []struct {
+		First string `xml:"first"`
+		Last  string `xml:"last"`
+	} `xml:"author"`
+	URL string `xml:"url"`
+	DOI string `xml:"doi"`
+}
+
+func (p aclPaper) toArticle(collectionID string, volume aclVolume, ingested time.Time, source string) domain.Article {
+	anthologyID := strings.TrimSpace(p.URL)
+	if anthologyID == "" || strings.Contains(anthologyID, "://") {
+		anthologyID = fmt.Sprintf("%s-%s.%s", collectionID, volume.ID, p.ID)
+	}
+
+	authors := make([]string, 0, len(p.Authors))
+	for _, author := range p.Authors {
+		if name := collapseSpaces(author.First + " " + author.Last); name != "" {
+			authors = append(authors, name)
+		}
+	}
+
+	return domain.Article{
+		ID:          "acl:" + anthologyID,
+		Title:       htmlText(p.Title.Value),
+		Abstract:    htmlText(p.Abstract.Value),
+		URL:         aclSiteURL + "/" + anthologyID + "/",
+		Source:      source,
+		Authors:     authors,
+		DOI:         strings.TrimSpace(p.DOI),
+		Venue:       htmlText(volume.Meta.BookTitle.Value),
+		PDFURL:      aclSiteURL + "/" + anthologyID + ".pdf",
+		PublishedAt: ingested,
+	}
+}
+
+func (a *ACLScanner) debug(msg string, args ...interface{}) {
+	if a.logger != nil {
+		a.logger.Debug(msg, args...)
+	}
+}
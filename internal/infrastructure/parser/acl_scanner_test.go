@@ -0,0 +1,116 @@
+package parser
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"ArticlesScanner/internal/scanner"
+)
+
+const aclCollectionXML = `<?xml version='1.0' encoding='UTF-8'?>
+<collection id="2024.acl">
+  <volume id="long" ingest-date="2024-08-05" type="proceedings">
+    <meta><booktitle>Proceedings of the 62nd Annual Meeting (Volume 1: <fixed-case>L</fixed-case>ong Papers)</booktitle><year>2024</year></meta>
+    <paper id="1">
+      <title>Quantized <fixed-case>S</fixed-case>ide Tuning</title>
+      <author><first>Zhengxin</first><last>Zhang</last></author>
+      <author><first>Dan</first><last>Zhao</last></author>
+      <abstract>Fine-tuning <tex-math>n</tex-math> models.</abstract>
+      <url hash="abc">2024.acl-long.1</url>
+      <doi>10.18653/v1/2024.acl-long.1</doi>
+    </paper>
+    <paper id="2"><title>Second</title></paper>
+  </volume>
+  <volume id="short" ingest-date="2024-08-01" type="proceedings">
+    <meta><booktitle>Short Papers</booktitle></meta>
+    <paper id="1"><title>Short one</title><url>2024.acl-short.1</url></paper>
+  </volume>
+</collection>`
+
+func TestACLCollectionID(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"acl-2024":          "2024.acl",
+		"EMNLP-2023":        "2023.emnlp",
+		"2024.naacl":        "2024.naacl",
+		"2023.findings-acl": "2023.findings-acl",
+	}
+	for input, want := range cases {
+		got, err := aclCollectionID(input)
+		if err != nil || got != want {
+			t.Fatalf("aclCollectionID(%s) = %s, %v; want %s", input, got, err, want)
+		}
+	}
+	if _, err := aclCollectionID("acl"); err == nil {
+		t.Fatal("expected error for name without year")
+	}
+}
+
+func TestACLScannerScan(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2024.acl.xml" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(aclCollectionXML))
+	}))
+	defer server.Close()
+
+	sc := NewACLScanner(server.Client(), nil)
+	req := scanner.Request{
+		Day:        time.Date(2024, time.August, 5, 9, 0, 0, 0, time.UTC),
+		SiteName:   "anthology",
+		Options:    map[string]string{"endpoint": server.URL},
+		Categories: []scanner.Category{{Name: "acl-2024"}},
+	}
+
+	articles, err := sc.Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+	if len(articles) != 3 {
+		t.Fatalf("expected 3 articles, got %d", len(articles))
+	}
+
+	first := articles[0]
+	if first.ID != "acl:2024.acl-long.1" || first.URL != "https://aclanthology.org/2024.acl-long.1/" {
+		t.Fatalf("unexpected identity: %s %s", first.ID, first.URL)
+	}
+	if first.Title != "Quantized Side Tuning" || first.Abstract != "Fine-tuning n models." {
+		t.Fatalf("unexpected text: %q %q", first.Title, first.Abstract)
+	}
+	if len(first.Authors) != 2 || first.Authors[1] != "Dan Zhao" || first.DOI != "10.18653/v1/2024.acl-long.1" {
+		t.Fatalf("unexpected metadata: %+v", first)
+	}
+	if first.Venue != "Proceedings of the 62nd Annual Meeting (Volume 1: Long Papers)" || first.Source != "anthology/acl-2024" {
+		t.Fatalf("unexpected venue/source: %q %q", first.Venue, first.Source)
+	}
+	if articles[1].ID != "acl:2024.acl-long.2" {
+		t.Fatalf("expected derived anthology id, got %s", articles[1].ID)
+	}
+
+	req.Options["ingestDay"] = "true"
+	articles, err = sc.Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+	if len(articles) != 2 {
+		t.Fatalf("expected only volumes ingested on the day, got %d", len(articles))
+	}
+
+	req.Options = map[string]string{"endpoint": server.URL, "volumes": "short"}
+	articles, err = sc.Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+	if len(articles) != 1 || articles[0].ID != "acl:2024.acl-short.1" {
+		t.Fatalf("unexpected volume filter result: %+v", articles)
+	}
+}
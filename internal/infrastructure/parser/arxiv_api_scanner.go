@@ -0,0 +1,198 @@
+package parser
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/scanner"
+)
+
+const (
+	arxivAPIEndpoint   = "https://export.arxiv.org/api/query"
+	arxivAPIPageSize   = 100
+	arxivAPIMaxResults = 2000
+)
+
+var arxivVersionExpr = regexp.MustCompile(`v\d+$`)
+
+// ArxivAPIScanner runs arbitrary search expressions against the arXiv Atom query API.
+type ArxivAPIScanner struct {
+	client   *http.Client
+	pageSize int
+	logger   *slog.Logger
+}
+
+// NewArxivAPIScanner wires an HTTP client; pageSize defaults to 100.
+func NewArxivAPIScanner(client *http.Client, log *slog.Logger) *ArxivAPIScanner {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &ArxivAPIScanner{client: client, pageSize: arxivAPIPageSize, logger: log}
+}
+
+// Name identifies the strategy inside the registry.
+func (a *ArxivAPIScanner) Name() string {
+	return "arxiv-api"
+}
+
+// Scan pages through newest-first results for each query and keeps entries submitted on the requested day.
+//
+// Each category URL holds a search expression (e.g. abs:"diffusion" AND cat:cs.LG) labelled by the category
+// name; without categories the "query" option is used. "endpoint" and "maxResults" options are optional.
+func (a *ArxivAPIScanner) Scan(ctx context.Context, req scanner.Request) ([]domain.Article, error) {
+	queries := req.Categories
+	if len(queries) == 0 {
+		queries = []scanner.Category{{URL: req.Options["query"]}}
+	}
+
+	endpoint := optionOr(req.Options, "endpoint", arxivAPIEndpoint)
+	maxResults := arxivAPIMaxResults
+	if raw := req.Options["maxResults"]; raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			return nil, fmt.Errorf("invalid maxResults %q", raw)
+		}
+		maxResults = parsed
+	}
+
+	a.debug("scan start", "site", req.SiteName, "queries", len(queries), "target_day", req.Day.Format("2006-01-02"))
+
+	results := make([]domain.Article, 0)
+	seen := map[string]struct{}{}
+	for _, q := range queries {
+		expr := strings.TrimSpace(q.URL)
+		if expr == "" {
+			expr = strings.TrimSpace(req.Options["query"])
+		}
+		if expr == "" {
+			return nil, fmt.Errorf("no search query for site %s category %s", req.SiteName, q.Name)
+		}
+
+		articles, err := a.search(ctx, endpoint, expr, req.Day, maxResults, articleSource(req.SiteName, q.Name))
+		if err != nil {
+			return nil, fmt.Errorf("query %s: %w", expr, err)
+		}
+		for _, article := range articles {
+			if _, ok := seen[article.ID]; ok {
+				continue
+			}
+			seen[article.ID] = struct{}{}
+			results = append(results, article)
+		}
+	}
+
+	a.debug("scan finished", "site", req.SiteName, "total", len(results))
+	return results, nil
+}
+
+func (a *ArxivAPIScanner) search(ctx context.Context, endpoint, expr string, day time.Time, maxResults int, source string) ([]domain.Article, error) {
+	dayStart := startOfDay(day)
+
+	var collected []domain.Article
+	for start := 0; start < maxResults; start += a.pageSize {
+		query := url.Values{}
+		query.Set("search_query", expr)
+		query.Set("start", strconv.Itoa(start))
+		query.Set("max_results", strconv.Itoa(a.pageSize))
+		query.Set("sortBy", "submittedDate")
+		query.Set("sortOrder", "descending")
+
+		pageURL, err := withQuery(endpoint, query)
+		if err != nil {
+			return nil, err
+		}
+		a.debug("requesting", "url", pageURL)
+
+		body, err := fetchBody(ctx, a.client, pageURL, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var feed arxivAtomFeed
+		if err := xml.Unmarshal(body, &feed); err != nil {
+			return nil, fmt.Errorf("decode atom feed: %w", err)
+		}
+
+		reachedOlder := false
+		for _, entry := range feed.Entries {
+			published, err := time.Parse(time.RFC3339, strings.TrimSpace(entry.Published))
+			if err != nil {
+				continue
+			}
+			if published.Before(dayStart) {
+				reachedOlder = true
+				break
+			}
+			if sameDay(published, day) {
+				collected = append(collected, entry.toArticle(published, source))
+			}
+		}
+
+		a.debug("page processed", "start", start, "entries", len(feed.Entries), "collected", len(collected))
+		if reachedOlder || len(feed.Entries) < a.pageSize {
+			break
+		}
+	}
+
+	return collected, nil
+}
+
+type arxivAtomFeed struct {
+	Entries []arxivAtomEntry `xml:"entry"`
+}
+
+type arxivAtomEntry struct {
+	ID        string `xml:"id"`
+	Published string `xml:"published"`
+	Title     string `xml:"title"`
+	Summary   string `xml:"summary"`
+	Authors   []struct {
+		Name string `xml:"name"`
+	} `xml:"author"`
+	PrimaryCategory struct {
+		Term string `xml:"term,attr"`
+	} `xml:"primary_category"`
+}
+
+func (e arxivAtomEntry) toArticle(published time.Time, source string) domain.Article {
+	id := strings.TrimSpace(e.ID)
+	if idx := strings.Index(id, "/abs/"); idx >= 0 {
+		id = id[idx+len("/abs/"):]
+	}
+	version, _ := strconv.Atoi(strings.TrimPrefix(arxivVersionExpr.FindString(id), "v"))
+	id = arxivVersionExpr.ReplaceAllString(id, "")
+
+	authors := make([]string, 0, len(e.Authors))
+	for _, author := range e.Authors {
+		if name := collapseSpaces(author.Name); name != "" {
+			authors = append(authors, name)
+		}
+	}
+
+	return domain.Article{
+		ID:              "arXiv:" + id,
+		Title:           collapseSpaces(e.Title),
+		Abstract:        collapseSpaces(e.Summary),
+		URL:             strings.TrimSuffix(arxivBaseURL, "/") + "/abs/" + id,
+		Source:          source,
+		Authors:         authors,
+		PrimaryCategory: e.PrimaryCategory.Term,
+		Version:         version,
+		PublishedAt:     published,
+	}
+}
+
+func (a *ArxivAPIScanner) debug(msg string, args ...interface{}) {
+	if a.logger != nil {
+		a.logger.Debug(msg, args...)
+	}
+}
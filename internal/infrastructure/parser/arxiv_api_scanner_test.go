@@ -0,0 +1,105 @@
+package parser
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"ArticlesScanner/internal/scanner"
+)
+
+func atomEntryXML(id, published, title string) string {
+	return fmt.Sprintf(`
+  <entry>
+    <id>http://arxiv.org/abs/%s</id>
+    <published>%s</published>
+    <title>%s</title>
+    <summary>Summary of %s.</summary>
+    <author><name>Ada Lovelace</name></author>
+    <author><name>Alan Turing</name></author>
+    <arxiv:primary_category xmlns:arxiv="http://arxiv.org/schemas/atom" term="cs.LG" scheme="http://arxiv.org/schemas/atom"/>
+  </entry>`, id, published, title, title)
+}
+
+func TestArxivAPIScannerScan(t *testing.T) {
+	t.Parallel()
+
+	targetDay := time.Date(2025, time.November, 8, 0, 0, 0, 0, time.UTC)
+
+	var starts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("search_query") != `abs:"diffusion" AND cat:cs.LG` {
+			t.Errorf("unexpected search_query %q", q.Get("search_query"))
+		}
+		if q.Get("sortBy") != "submittedDate" || q.Get("sortOrder") != "descending" {
+			t.Errorf("unexpected sort: %s", r.URL.RawQuery)
+		}
+		starts = append(starts, q.Get("start"))
+
+		var entries string
+		switch q.Get("start") {
+		case "0":
+			entries = atomEntryXML("2511.00009v1", "2025-11-09T01:00:00Z", "Tomorrow") +
+				atomEntryXML("2511.00008v2", "2025-11-08T20:00:00Z", "Evening")
+		case "2":
+			entries = atomEntryXML("2511.00007v1", "2025-11-08T03:00:00Z", "Morning") +
+				atomEntryXML("2511.00006v1", "2025-11-07T23:00:00Z", "Yesterday")
+		default:
+			t.Errorf("paged past older entries: start=%s", q.Get("start"))
+		}
+		_, _ = w.Write([]byte(`<feed xmlns="http://www.w3.org/2005/Atom">` + entries + `</feed>`))
+	}))
+	defer server.Close()
+
+	sc := NewArxivAPIScanner(server.Client(), nil)
+	sc.pageSize = 2
+
+	req := scanner.Request{
+		Day:      targetDay,
+		SiteName: "arxiv-topics",
+		Options:  map[string]string{"endpoint": server.URL + "/api/query"},
+		Categories: []scanner.Category{
+			{Name: "diffusion", URL: `abs:"diffusion" AND cat:cs.LG`},
+		},
+	}
+
+	articles, err := sc.Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+
+	if len(starts) != 2 {
+		t.Fatalf("expected 2 pages, got %v", starts)
+	}
+	if len(articles) != 2 {
+		t.Fatalf("expected 2 articles, got %d", len(articles))
+	}
+
+	first := articles[0]
+	if first.ID != "arXiv:2511.00008" || first.Title != "Evening" {
+		t.Fatalf("unexpected first article: %+v", first)
+	}
+	if first.Source != "arxiv-topics/diffusion" || first.PrimaryCategory != "cs.LG" {
+		t.Fatalf("unexpected source/category: %s %s", first.Source, first.PrimaryCategory)
+	}
+	if len(first.Authors) != 2 || first.Authors[1] != "Alan Turing" {
+		t.Fatalf("unexpected authors: %v", first.Authors)
+	}
+	if articles[1].URL != "https://arxiv.org/abs/2511.00007" {
+		t.Fatalf("unexpected url: %s", articles[1].URL)
+	}
+}
+
+func TestArxivAPIScannerRequiresQuery(t *testing.T) {
+	t.Parallel()
+
+	sc := NewArxivAPIScanner(nil, nil)
+	_, err := sc.Scan(context.Background(), scanner.Request{SiteName: "empty", Day: time.Now()})
+	if err == nil {
+		t.Fatal("expected error without query")
+	}
+}
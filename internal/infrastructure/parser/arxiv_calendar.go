@@ -0,0 +1,110 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	arxivTimezone = "America/New_York"
+	// arxivAnnounceHour is when a mailing goes public (20:00 ET the evening before its listing date);
+	// submissions received before the 14:00 ET cutoff make that evening's mailing.
+	arxivAnnounceHour = 20
+	// arxivMaxGap bounds calendar walks so a misconfigured holiday table cannot loop forever.
+	arxivMaxGap = 31
+)
+
+// ArxivCalendar models the arXiv mailing cycle: listings are dated Monday to Friday,
+// announced at 20:00 ET the previous evening, and skipped on configured holidays.
+type ArxivCalendar struct {
+	location *time.Location
+	holidays map[string]struct{}
+}
+
+// NewArxivCalendar parses holiday listing dates given as "2006-01-02".
+func NewArxivCalendar(holidays []string) (*ArxivCalendar, error) {
+	location, err := time.LoadLocation(arxivTimezone)
+	if err != nil {
+		location = time.FixedZone("EST", -5*60*60)
+	}
+
+	calendar := &ArxivCalendar{location: location, holidays: map[string]struct{}{}}
+	for _, value := range holidays {
+		day, err := time.Parse("2006-01-02", strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid arxiv holiday %q: %w", value, err)
+		}
+		calendar.holidays[day.Format("2006-01-02")] = struct{}{}
+	}
+	return calendar, nil
+}
+
+// IsMailingDay reports whether a listing is published for the given date.
+func (c *ArxivCalendar) IsMailingDay(day time.Time) bool {
+	switch day.Weekday() {
+	case time.Saturday, time.Sunday:
+		return false
+	}
+	_, holiday := c.holidays[day.Format("2006-01-02")]
+	return !holiday
+}
+
+// Current returns the listing date of the latest mailing announced at or before at (UTC midnight),
+// or the zero time when none is found within arxivMaxGap days.
+func (c *ArxivCalendar) Current(at time.Time) time.Time {
+	y, m, d := at.In(c.location).Date()
+	// Tomorrow's listing is already public after 20:00 ET today.
+	day := time.Date(y, m, d+1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i <= arxivMaxGap; i++ {
+		if c.IsMailingDay(day) && !c.announcedAt(day).After(at) {
+			return day
+		}
+		day = day.AddDate(0, 0, -1)
+	}
+	return time.Time{}
+}
+
+// Batches lists the listing dates (newest first) announced since the previous weekday run at the
+// same clock time, so Monday runs collect everything after Friday and weekend runs collect nothing new.
+func (c *ArxivCalendar) Batches(at time.Time) []time.Time {
+	previousRun := at.AddDate(0, 0, -1)
+	for previousRun.Weekday() == time.Saturday || previousRun.Weekday() == time.Sunday {
+		previousRun = previousRun.AddDate(0, 0, -1)
+	}
+	since := c.Current(previousRun)
+
+	var batches []time.Time
+	day := c.Current(at)
+	for i := 0; i <= arxivMaxGap && !day.IsZero() && day.After(since); i++ {
+		if c.IsMailingDay(day) {
+			batches = append(batches, day)
+		}
+		day = day.AddDate(0, 0, -1)
+	}
+	return batches
+}
+
+func (c *ArxivCalendar) announcedAt(day time.Time) time.Time {
+	y, m, d := day.Date()
+	return time.Date(y, m, d-1, arxivAnnounceHour, 0, 0, 0, c.location)
+}
+
+// announcementDays holds the listing dates a run collects, newest first.
+type announcementDays []time.Time
+
+func (d announcementDays) contains(day time.Time) bool {
+	for _, batch := range d {
+		if batch.Equal(day) {
+			return true
+		}
+	}
+	return false
+}
+
+func (d announcementDays) oldest() time.Time {
+	if len(d) == 0 {
+		return time.Time{}
+	}
+	return d[len(d)-1]
+}
@@ -0,0 +1,50 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestArxivCalendarBatches(t *testing.T) {
+	t.Parallel()
+
+	calendar, err := NewArxivCalendar([]string{"2025-11-27"})
+	if err != nil {
+		t.Fatalf("NewArxivCalendar error: %v", err)
+	}
+	moscow := time.FixedZone("MSK", 3*60*60)
+
+	cases := []struct {
+		name string
+		at   time.Time
+		want []string
+	}{
+		{"tuesday after announcement", time.Date(2025, time.November, 11, 6, 0, 0, 0, moscow), []string{"2025-11-11"}},
+		{"before evening announcement", time.Date(2025, time.November, 11, 2, 0, 0, 0, moscow), []string{"2025-11-10"}},
+		{"saturday", time.Date(2025, time.November, 8, 6, 0, 0, 0, moscow), nil},
+		{"sunday", time.Date(2025, time.November, 9, 6, 0, 0, 0, moscow), nil},
+		{"monday after friday", time.Date(2025, time.November, 10, 6, 0, 0, 0, moscow), []string{"2025-11-10"}},
+		{"monday before announcement", time.Date(2025, time.November, 10, 2, 0, 0, 0, moscow), []string{"2025-11-07"}},
+		{"holiday skipped", time.Date(2025, time.November, 27, 6, 0, 0, 0, moscow), nil},
+		{"day after holiday", time.Date(2025, time.November, 28, 6, 0, 0, 0, moscow), []string{"2025-11-28"}},
+	}
+
+	for _, tc := range cases {
+		got := formatDays(calendar.Batches(tc.at))
+		if len(got) == 0 && len(tc.want) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestArxivCalendarRejectsInvalidHoliday(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewArxivCalendar([]string{"25 Dec"}); err == nil {
+		t.Fatal("expected error for malformed holiday")
+	}
+}
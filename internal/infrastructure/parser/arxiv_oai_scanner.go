@@ -0,0 +1,241 @@
+package parser
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/infrastructure/oaipmh"
+	"ArticlesScanner/internal/scanner"
+)
+
+const (
+	arxivOAIEndpoint   = "https://export.arxiv.org/oai2"
+	arxivRawPrefix     = "arXivRaw"
+	arxivPrefix        = "arXiv"
+	arxivRawDateLayout = "Mon, 2 Jan 2006 15:04:05 MST"
+)
+
+// arxivTopLevelArchives lists archives that are OAI sets on their own; the rest live under physics.
+var arxivTopLevelArchives = map[string]bool{
+	"cs":    true,
+	"econ":  true,
+	"eess":  true,
+	"math":  true,
+	"q-bio": true,
+	"q-fin": true,
+	"stat":  true,
+}
+
+// ArxivOAIScanner harvests arXiv metadata through the OAI-PMH ListRecords verb.
+type ArxivOAIScanner struct {
+	client *http.Client
+	logger *slog.Logger
+}
+
+// NewArxivOAIScanner wires an HTTP client; nil falls back to a client with a sane timeout.
+func NewArxivOAIScanner(client *http.Client, log *slog.Logger) *ArxivOAIScanner {
+	if client == nil {
+		client = &http.Client{Timeout: 60 * time.Second}
+	}
+	return &ArxivOAIScanner{client: client, logger: log}
+}
+
+// Name identifies the strategy inside the registry.
+func (a *ArxivOAIScanner) Name() string {
+	return "arxiv-oai"
+}
+
+// Scan harvests records whose datestamp equals the requested day and keeps those matching configured categories.
+//
+// Options: "endpoint" overrides the OAI base URL, "metadataPrefix" selects arXivRaw (default) or arXiv.
+// A category URL, when present, overrides the endpoint for that category.
+func (a *ArxivOAIScanner) Scan(ctx context.Context, req scanner.Request) ([]domain.Article, error) {
+	if len(req.Categories) == 0 {
+		return nil, fmt.Errorf("no categories provided for site %s", req.SiteName)
+	}
+
+	prefix := optionOr(req.Options, "metadataPrefix", arxivRawPrefix)
+	if prefix != arxivRawPrefix && prefix != arxivPrefix {
+		return nil, fmt.Errorf("unsupported metadataPrefix %s", prefix)
+	}
+	day := req.Day.Format("2006-01-02")
+
+	a.debug("scan start", "site", req.SiteName, "categories", len(req.Categories), "target_day", day, "prefix", prefix)
+
+	harvested := map[string][]oaiArxivEntry{}
+	results := make([]domain.Article, 0)
+	seen := map[string]struct{}{}
+
+	for _, cat := range req.Categories {
+		endpoint := strings.TrimSpace(cat.URL)
+		if endpoint == "" {
+			endpoint = optionOr(req.Options, "endpoint", arxivOAIEndpoint)
+		}
+		set := arxivSetSpec(cat.Name)
+
+		key := endpoint + "|" + set
+		entries, ok := harvested[key]
+		if !ok {
+			var err error
+			entries, err = a.harvest(ctx, endpoint, set, prefix, day)
+			if err != nil {
+				return nil, fmt.Errorf("category %s: %w", cat.Name, err)
+			}
+			harvested[key] = entries
+		}
+
+		matched := 0
+		for _, entry := range entries {
+			if !entry.inCategory(cat.Name) {
+				continue
+			}
+			article := entry.toArticle(articleSource(req.SiteName, cat.Name))
+			if _, ok := seen[article.ID]; ok {
+				continue
+			}
+			seen[article.ID] = struct{}{}
+			results = append(results, article)
+			matched++
+		}
+		a.debug("category processed", "category", cat.Name, "set", set, "articles", matched)
+	}
+
+	a.debug("scan finished", "site", req.SiteName, "total", len(results))
+	return results, nil
+}
+
+func (a *ArxivOAIScanner) harvest(ctx context.Context, endpoint, set, prefix, day string) ([]oaiArxivEntry, error) {
+	opts := oaipmh.ListRecordsOptions{MetadataPrefix: prefix, Set: set, From: day, Until: day}
+
+	var entries []oaiArxivEntry
+	err := oaipmh.NewClient(endpoint, a.client, a.logger).ListRecords(ctx, opts, func(record oaipmh.Record) error {
+		if record.Header.Deleted() {
+			return nil
+		}
+		var meta oaiArxivMetadata
+		if err := record.Metadata.Decode(&meta); err != nil {
+			return err
+		}
+		if entry, ok := meta.entry(record.Header); ok {
+			entries = append(entries, entry)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// arxivSetSpec maps a category such as cs.AI or hep-th to its OAI set (cs, physics:hep-th).
+func arxivSetSpec(category string) string {
+	category = strings.TrimSpace(category)
+	if category == "" || strings.Contains(category, ":") {
+		return category
+	}
+	archive, _, _ := strings.Cut(category, ".")
+	if arxivTopLevelArchives[archive] {
+		return archive
+	}
+	return "physics:" + archive
+}
+
+// oaiArxivMetadata matches either arXivRaw or arXiv payloads inside a record's metadata element.
+type oaiArxivMetadata struct {
+	Raw *struct {
+		ID       string `xml:"id"`
+		Title    string `xml:"title"`
+		Cats     string `xml:"categories"`
+		Abstract string `xml:"abstract"`
+		Versions []struct {
+			Date string `xml:"date"`
+		} `xml:"version"`
+	} `xml:"arXivRaw"`
+	Arxiv *struct {
+		ID       string `xml:"id"`
+		Created  string `xml:"created"`
+		Title    string `xml:"title"`
+		Cats     string `xml:"categories"`
+		Abstract string `xml:"abstract"`
+	} `xml:"arXiv"`
+}
+
+// oaiArxivEntry is the format-agnostic view over arXivRaw and arXiv metadata.
+type oaiArxivEntry struct {
+	id          string
+	title       string
+	abstract    string
+	categories  []string
+	publishedAt time.Time
+}
+
+func (m oaiArxivMetadata) entry(header oaipmh.Header) (oaiArxivEntry, bool) {
+	datestamp, _ := time.Parse("2006-01-02", strings.TrimSpace(header.Datestamp))
+	entry := oaiArxivEntry{publishedAt: datestamp}
+
+	switch {
+	case m.Raw != nil:
+		raw := m.Raw
+		entry.id = raw.ID
+		entry.title = raw.Title
+		entry.abstract = raw.Abstract
+		entry.categories = strings.Fields(raw.Cats)
+		if len(raw.Versions) > 0 {
+			if parsed, err := time.Parse(arxivRawDateLayout, strings.TrimSpace(raw.Versions[0].Date)); err == nil {
+				entry.publishedAt = parsed
+			}
+		}
+	case m.Arxiv != nil:
+		meta := m.Arxiv
+		entry.id = meta.ID
+		entry.title = meta.Title
+		entry.abstract = meta.Abstract
+		entry.categories = strings.Fields(meta.Cats)
+		if parsed, err := time.Parse("2006-01-02", strings.TrimSpace(meta.Created)); err == nil {
+			entry.publishedAt = parsed
+		}
+	default:
+		return oaiArxivEntry{}, false
+	}
+
+	entry.id = strings.TrimSpace(entry.id)
+	if entry.id == "" {
+		entry.id = strings.TrimPrefix(strings.TrimSpace(header.Identifier), "oai:arXiv.org:")
+	}
+	return entry, entry.id != ""
+}
+
+// inCategory reports whether the entry belongs to the configured category; archive-level names match everything.
+func (e oaiArxivEntry) inCategory(category string) bool {
+	if !strings.Contains(category, ".") {
+		return true
+	}
+	for _, c := range e.categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+func (e oaiArxivEntry) toArticle(source string) domain.Article {
+	return domain.Article{
+		ID:          "arXiv:" + e.id,
+		Title:       collapseSpaces(e.title),
+		Abstract:    collapseSpaces(e.abstract),
+		URL:         strings.TrimSuffix(arxivBaseURL, "/") + "/abs/" + e.id,
+		Source:      source,
+		PublishedAt: e.publishedAt,
+	}
+}
+
+func (a *ArxivOAIScanner) debug(msg string, args ...interface{}) {
+	if a.logger != nil {
+		a.logger.Debug(msg, args...)
+	}
+}
@@ -0,0 +1,154 @@
+package parser
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"ArticlesScanner/internal/scanner"
+)
+
+func TestArxivSetSpec(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"cs.AI":          "cs",
+		"math.PR":        "math",
+		"hep-th":         "physics:hep-th",
+		"astro-ph.CO":    "physics:astro-ph",
+		"physics:hep-ex": "physics:hep-ex",
+	}
+	for category, want := range cases {
+		if got := arxivSetSpec(category); got != want {
+			t.Fatalf("arxivSetSpec(%s) = %s, want %s", category, got, want)
+		}
+	}
+}
+
+func TestArxivOAIScannerScan(t *testing.T) {
+	t.Parallel()
+
+	targetDay := time.Date(2025, time.November, 8, 0, 0, 0, 0, time.UTC)
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		q := r.URL.Query()
+		if q.Get("verb") != "ListRecords" {
+			t.Errorf("unexpected verb %s", q.Get("verb"))
+		}
+
+		if token := q.Get("resumptionToken"); token != "" {
+			if token != "page-2" || q.Get("set") != "" {
+				t.Errorf("unexpected resumption query: %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+			<OAI-PMH xmlns="http://www.openarchives.org/OAI/2.0/">
+			  <ListRecords>
+			    <record>
+			      <header><identifier>oai:arXiv.org:2511.00003</identifier><datestamp>2025-11-08</datestamp><setSpec>cs</setSpec></header>
+			      <metadata>
+			        <arXivRaw xmlns="http://arxiv.org/OAI/arXivRaw/">
+			          <id>2511.00003</id>
+			          <version version="v1"><date>Fri, 7 Nov 2025 18:00:00 GMT</date></version>
+			          <title>Learning
+			  Things</title>
+			          <categories>cs.LG stat.ML</categories>
+			          <abstract>  Second page.  </abstract>
+			        </arXivRaw>
+			      </metadata>
+			    </record>
+			    <resumptionToken cursor="2" completeListSize="3"></resumptionToken>
+			  </ListRecords>
+			</OAI-PMH>`))
+			return
+		}
+
+		if q.Get("metadataPrefix") != "arXivRaw" || q.Get("set") != "cs" ||
+			q.Get("from") != "2025-11-08" || q.Get("until") != "2025-11-08" {
+			t.Errorf("unexpected initial query: %s", r.URL.RawQuery)
+		}
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+		<OAI-PMH xmlns="http://www.openarchives.org/OAI/2.0/">
+		  <ListRecords>
+		    <record>
+		      <header><identifier>oai:arXiv.org:2511.00001</identifier><datestamp>2025-11-08</datestamp><setSpec>cs</setSpec></header>
+		      <metadata>
+		        <arXivRaw xmlns="http://arxiv.org/OAI/arXivRaw/">
+		          <id>2511.00001</id>
+		          <version version="v1"><date>Fri, 7 Nov 2025 17:00:00 GMT</date></version>
+		          <title>Agents</title>
+		          <categories>cs.AI</categories>
+		          <abstract>First page.</abstract>
+		        </arXivRaw>
+		      </metadata>
+		    </record>
+		    <record>
+		      <header status="deleted"><identifier>oai:arXiv.org:2511.00002</identifier><datestamp>2025-11-08</datestamp></header>
+		    </record>
+		    <resumptionToken cursor="0" completeListSize="3">page-2</resumptionToken>
+		  </ListRecords>
+		</OAI-PMH>`))
+	}))
+	defer server.Close()
+
+	sc := NewArxivOAIScanner(server.Client(), nil)
+	req := scanner.Request{
+		Day:      targetDay,
+		SiteName: "arxiv-oai",
+		Options:  map[string]string{"endpoint": server.URL + "/oai2"},
+		Categories: []scanner.Category{
+			{Name: "cs.AI"},
+			{Name: "cs.LG"},
+		},
+	}
+
+	articles, err := sc.Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+
+	if calls != 2 {
+		t.Fatalf("expected one harvest of two pages, got %d calls", calls)
+	}
+	if len(articles) != 2 {
+		t.Fatalf("expected 2 articles, got %d", len(articles))
+	}
+
+	if articles[0].ID != "arXiv:2511.00001" || articles[0].Source != "arxiv-oai/cs.AI" {
+		t.Fatalf("unexpected first article: %+v", articles[0])
+	}
+	if articles[1].Title != "Learning Things" || articles[1].Abstract != "Second page." {
+		t.Fatalf("unexpected second article: %+v", articles[1])
+	}
+	if articles[1].URL != "https://arxiv.org/abs/2511.00003" {
+		t.Fatalf("unexpected url: %s", articles[1].URL)
+	}
+	if articles[1].PublishedAt.Day() != 7 {
+		t.Fatalf("expected v1 date, got %v", articles[1].PublishedAt)
+	}
+}
+
+func TestArxivOAIScannerNoRecordsMatch(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<OAI-PMH><error code="noRecordsMatch">nothing</error></OAI-PMH>`))
+	}))
+	defer server.Close()
+
+	sc := NewArxivOAIScanner(server.Client(), nil)
+	articles, err := sc.Scan(context.Background(), scanner.Request{
+		Day:        time.Date(2025, time.November, 9, 0, 0, 0, 0, time.UTC),
+		SiteName:   "arxiv-oai",
+		Categories: []scanner.Category{{Name: "math.PR", URL: server.URL}},
+	})
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+	if len(articles) != 0 {
+		t.Fatalf("expected no articles, got %d", len(articles))
+	}
+}
@@ -2,6 +2,7 @@ package parser
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -21,21 +22,31 @@ const (
 	arxivBaseURL = "https://arxiv.org"
 )
 
-var dateExpr = regexp.MustCompile(`\d{1,2} [A-Za-z]{3} \d{4}`)
+var (
+	dateExpr           = regexp.MustCompile(`\d{1,2} [A-Za-z]{3,9},? \d{4}`)
+	linkVersionExpr    = regexp.MustCompile(`/(?:abs|pdf|html)/[^/?#]+v(\d+)/?$`)
+	subjectCodeExpr    = regexp.MustCompile(`\(([a-z-]+(?:\.[A-Za-z-]+)?)\)\s*$`)
+	listDescriptorExpr = regexp.MustCompile(`^(Title|Authors|Comments|Journal-ref|Subjects):\s*`)
+)
 
-// ArxivScanner crawls category pages and extracts articles for the requested day.
+// ArxivScanner crawls category pages and extracts the announcement batches due for the requested run.
 type ArxivScanner struct {
 	client   *http.Client
+	calendar *ArxivCalendar
 	pageSize int
 	logger   *slog.Logger
 }
 
-// NewArxivScanner wires an HTTP client; pageSize defaults to 200.
-func NewArxivScanner(client *http.Client, log *slog.Logger) *ArxivScanner {
+// NewArxivScanner wires an HTTP client and mailing calendar (weekdays without holidays when nil);
+// pageSize defaults to 200.
+func NewArxivScanner(client *http.Client, calendar *ArxivCalendar, log *slog.Logger) *ArxivScanner {
 	if client == nil {
 		client = &http.Client{Timeout: 20 * time.Second}
 	}
-	return &ArxivScanner{client: client, pageSize: 200, logger: log}
+	if calendar == nil {
+		calendar, _ = NewArxivCalendar(nil)
+	}
+	return &ArxivScanner{client: client, calendar: calendar, pageSize: 200, logger: log}
 }
 
 // Name identifies the strategy inside the registry.
@@ -43,60 +54,104 @@ func (a *ArxivScanner) Name() string {
 	return "arxiv"
 }
 
-// Scan walks through each category URL and returns all articles published on the requested day.
+// Scan walks through each category URL and returns all articles from the mailings announced since the
+// previous weekday run; req.Day is the run instant, resolved against the arXiv announcement calendar.
+//
+// Options "includeCrossLists" and "includeReplacements" set to "false" drop those announcement types.
+// Failing categories do not stop the others: their articles come back with a *scanner.PartialError.
 func (a *ArxivScanner) Scan(ctx context.Context, req scanner.Request) ([]domain.Article, error) {
 	if len(req.Categories) == 0 {
 		return nil, fmt.Errorf("no categories provided for site %s", req.SiteName)
 	}
+	include := announcementFilter{
+		crossLists:   req.Options["includeCrossLists"] != "false",
+		replacements: req.Options["includeReplacements"] != "false",
+	}
 
-	a.debug("scan start", "site", req.SiteName, "categories", len(req.Categories), "target_day", req.Day.Format("2006-01-02"))
+	batches := announcementDays(a.calendar.Batches(req.Day))
+	a.debug("scan start", "site", req.SiteName, "categories", len(req.Categories), "run", req.Day.Format(time.RFC3339), "batches", formatDays(batches))
 
-	targetDay := req.Day.UTC().Truncate(24 * time.Hour)
 	results := make([]domain.Article, 0)
-	seen := map[string]struct{}{}
-
-	for _, cat := range req.Categories {
-		skip := 0
-		for {
-			pageURL, err := buildPageURL(cat.URL, skip, a.pageSize)
-			if err != nil {
-				return nil, fmt.Errorf("category %s: %w", cat.Name, err)
-			}
-			a.debug("fetching", "site", req.SiteName, "category", cat.Name, "skip", skip, "url", pageURL)
+	if len(batches) == 0 {
+		a.debug("no arxiv announcements since previous run", "site", req.SiteName)
+		return results, nil
+	}
 
-			doc, err := a.fetchDocument(ctx, pageURL)
-			if err != nil {
-				return nil, fmt.Errorf("category %s: %w", cat.Name, err)
-			}
+	perCategory := make([][]domain.Article, len(req.Categories))
+	errs := runLimited(ctx, req.Workers, len(req.Categories), func(ctx context.Context, i int) error {
+		articles, err := a.scanCategory(ctx, req, req.Categories[i], batches, include)
+		if err != nil {
+			return err
+		}
+		perCategory[i] = articles
+		return nil
+	})
 
-			pageArticles, shouldContinue := a.extractArticles(doc, targetDay, req.SiteName, cat.Name)
-			a.debug("page processed", "category", cat.Name, "skip", skip, "articles", len(pageArticles), "continue", shouldContinue)
-			for _, article := range pageArticles {
-				if _, ok := seen[article.ID]; ok {
-					continue
-				}
-				seen[article.ID] = struct{}{}
-				results = append(results, article)
-			}
+	var partial scanner.PartialError
+	for i, err := range errs {
+		if err != nil {
+			partial.Failures = append(partial.Failures, scanner.CategoryFailure{Category: req.Categories[i].Name, Err: err})
+		}
+	}
+	if len(partial.Failures) == len(req.Categories) {
+		causes := make([]error, 0, len(partial.Failures))
+		for _, failure := range partial.Failures {
+			causes = append(causes, fmt.Errorf("category %s: %w", failure.Category, failure.Err))
+		}
+		return nil, errors.Join(causes...)
+	}
 
-			if !shouldContinue {
-				break
+	seen := map[string]struct{}{}
+	for _, articles := range perCategory {
+		for _, article := range articles {
+			if _, ok := seen[article.ID]; ok {
+				continue
 			}
-			skip += a.pageSize
+			seen[article.ID] = struct{}{}
+			results = append(results, article)
 		}
 	}
 
-	a.debug("scan finished", "site", req.SiteName, "total", len(results))
+	a.debug("scan finished", "site", req.SiteName, "total", len(results), "failed_categories", len(partial.Failures))
+	if len(partial.Failures) > 0 {
+		return results, &partial
+	}
 	return results, nil
 }
 
+// scanCategory pages through one category listing until entries predate the oldest batch.
+func (a *ArxivScanner) scanCategory(ctx context.Context, req scanner.Request, cat scanner.Category, batches announcementDays, include announcementFilter) ([]domain.Article, error) {
+	var collected []domain.Article
+	skip := 0
+	for {
+		pageURL, err := buildPageURL(cat.URL, skip, a.pageSize)
+		if err != nil {
+			return nil, err
+		}
+		a.debug("fetching", "site", req.SiteName, "category", cat.Name, "skip", skip, "url", pageURL)
+
+		doc, err := a.fetchDocument(ctx, pageURL)
+		if err != nil {
+			return nil, err
+		}
+
+		pageArticles, shouldContinue := a.extractArticles(doc, batches, include, req.SiteName, cat.Name)
+		a.debug("page processed", "category", cat.Name, "skip", skip, "articles", len(pageArticles), "continue", shouldContinue)
+		collected = append(collected, pageArticles...)
+
+		if !shouldContinue {
+			return collected, nil
+		}
+		skip += a.pageSize
+	}
+}
+
 func (a *ArxivScanner) fetchDocument(ctx context.Context, pageURL string) (*goquery.Document, error) {
 	a.debug("requesting", "url", pageURL)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("build request: %w", err)
 	}
-	req.Header.Set("User-Agent", "ArticlesScanner/1.0")
 
 	resp, err := a.client.Do(req)
 	if err != nil {
@@ -126,7 +181,24 @@ func (a *ArxivScanner) fetchDocument(ctx context.Context, pageURL string) (*goqu
 	return doc, nil
 }
 
-func (a *ArxivScanner) extractArticles(doc *goquery.Document, targetDay time.Time, siteName, category string) ([]domain.Article, bool) {
+// announcementFilter keeps new submissions always and cross-lists/replacements on request.
+type announcementFilter struct {
+	crossLists   bool
+	replacements bool
+}
+
+func (f announcementFilter) allows(kind domain.AnnouncementType) bool {
+	switch kind {
+	case domain.AnnouncementCrossList:
+		return f.crossLists
+	case domain.AnnouncementReplacement:
+		return f.replacements
+	default:
+		return true
+	}
+}
+
+func (a *ArxivScanner) extractArticles(doc *goquery.Document, batches announcementDays, include announcementFilter, siteName, category string) ([]domain.Article, bool) {
 	var (
 		collected    []domain.Article
 		continueScan = true
@@ -139,14 +211,15 @@ func (a *ArxivScanner) extractArticles(doc *goquery.Document, targetDay time.Tim
 
 		article, publishedAt, err := parseEntry(dt, dd, siteName, category)
 		if err != nil {
+			a.debug("skipping entry", "index", i, "error", err)
 			return true
 		}
 
 		articleDay := publishedAt.UTC().Truncate(24 * time.Hour)
-		if articleDay.Equal(targetDay) {
+		if batches.contains(articleDay) && include.allows(article.Announcement) {
 			collected = append(collected, article)
 		}
-		if articleDay.Before(targetDay) {
+		if articleDay.Before(batches.oldest()) {
 			continueScan = false
 			return false
 		}
@@ -189,53 +262,173 @@ func parseEntry(dt, dd *goquery.Selection, siteName, category string) (domain.Ar
 	summary = strings.TrimPrefix(summary, "Abstract:")
 	summary = strings.TrimSpace(summary)
 
+	headings := precedingHeadings(dt)
+
+	// Entries on pastweek pages carry their own date; /new pages date the whole listing in a heading.
 	dateText := strings.TrimSpace(dd.Find(".list-date").First().Text())
 	if dateText == "" {
 		dateText = strings.TrimSpace(dd.Find(".list-dateline").First().Text())
 	}
-
-	match := dateExpr.FindString(dateText)
-	publishedAt := time.Now().UTC()
-	if match != "" {
-		if parsed, err := time.Parse("2 Jan 2006", match); err == nil {
-			publishedAt = parsed
-		}
+	publishedAt, ok := parseListingDate(dateText)
+	for i := 0; !ok && i < len(headings); i++ {
+		publishedAt, ok = parseListingDate(headings[i])
+	}
+	if !ok {
+		return article, time.Time{}, fmt.Errorf("entry %s has no announcement date", id)
 	}
 
 	if id == "" {
 		id = href
 	}
 
-	source := siteName
-	if category != "" {
-		source = fmt.Sprintf("%s/%s", siteName, category)
+	announcement := announcementType(collapseSpaces(dt.Text()), headings)
+	version := 0
+	dt.Find("a[href]").Each(func(_ int, a *goquery.Selection) {
+		href, _ := a.Attr("href")
+		if match := linkVersionExpr.FindStringSubmatch(href); match != nil {
+			if v, err := strconv.Atoi(match[1]); err == nil && v > version {
+				version = v
+			}
+		}
+	})
+	if version == 0 && announcement == domain.AnnouncementNew {
+		version = 1
 	}
 
+	var authors []string
+	dd.Find(".list-authors a").Each(func(_ int, a *goquery.Selection) {
+		if name := collapseSpaces(a.Text()); name != "" {
+			authors = append(authors, name)
+		}
+	})
+
+	primary, secondary := parseSubjects(dd.Find(".list-subjects").First())
+
 	article = domain.Article{
-		ID:          id,
-		Title:       title,
-		Abstract:    summary,
-		URL:         href,
-		Source:      source,
-		PublishedAt: publishedAt,
+		ID:                  id,
+		Title:               title,
+		Abstract:            summary,
+		URL:                 href,
+		Source:              articleSource(siteName, category),
+		Authors:             authors,
+		PrimaryCategory:     primary,
+		SecondaryCategories: secondary,
+		Comments:            listField(dd, ".list-comments"),
+		JournalRef:          listField(dd, ".list-journal-ref"),
+		Announcement:        announcement,
+		Version:             version,
+		PublishedAt:         publishedAt,
 	}
 
 	return article, publishedAt, nil
 }
 
+// precedingHeadings lists h3 headings before dt, nearest first: inside its dl, then before the dl.
+func precedingHeadings(dt *goquery.Selection) []string {
+	var headings []string
+	for _, sel := range []*goquery.Selection{dt, dt.Parent()} {
+		sel.PrevAllFiltered("h3").Each(func(_ int, h *goquery.Selection) {
+			headings = append(headings, collapseSpaces(h.Text()))
+		})
+	}
+	return headings
+}
+
+// parseListingDate reads "8 Nov 2025" (pastweek) and "Friday, 7 November 2025" (/new) style dates.
+func parseListingDate(text string) (time.Time, bool) {
+	match := strings.Replace(dateExpr.FindString(text), ",", "", 1)
+	for _, layout := range []string{"2 Jan 2006", "2 January 2006"} {
+		if parsed, err := time.Parse(layout, match); err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// announcementType reads the per-entry markers first and falls back to the section heading.
+func announcementType(entryText string, headings []string) domain.AnnouncementType {
+	entryText = strings.ToLower(entryText)
+	switch {
+	case strings.Contains(entryText, "(cross-list from"):
+		return domain.AnnouncementCrossList
+	case strings.Contains(entryText, "(replaced)"):
+		return domain.AnnouncementReplacement
+	}
+	for _, heading := range headings {
+		heading = strings.ToLower(heading)
+		switch {
+		case strings.Contains(heading, "cross-list"), strings.Contains(heading, "cross submission"):
+			return domain.AnnouncementCrossList
+		case strings.Contains(heading, "replacement"):
+			return domain.AnnouncementReplacement
+		case strings.Contains(heading, "new submission"):
+			return domain.AnnouncementNew
+		}
+	}
+	return domain.AnnouncementNew
+}
+
+// listField returns the text of a listing metadata block without its "Comments:"-style descriptor.
+func listField(dd *goquery.Selection, selector string) string {
+	text := collapseSpaces(dd.Find(selector).First().Text())
+	return listDescriptorExpr.ReplaceAllString(text, "")
+}
+
+// parseSubjects splits "Machine Learning (cs.LG); Artificial Intelligence (cs.AI)" into category codes;
+// the .primary-subject span wins, otherwise the first subject is primary.
+func parseSubjects(subjects *goquery.Selection) (string, []string) {
+	if subjects.Length() == 0 {
+		return "", nil
+	}
+
+	primary := subjectCode(subjects.Find(".primary-subject").First().Text())
+	var secondary []string
+	for _, part := range strings.Split(listDescriptorExpr.ReplaceAllString(collapseSpaces(subjects.Text()), ""), ";") {
+		code := subjectCode(part)
+		switch {
+		case code == "":
+			continue
+		case primary == "":
+			primary = code
+		case code != primary:
+			secondary = append(secondary, code)
+		}
+	}
+	return primary, secondary
+}
+
+func subjectCode(subject string) string {
+	if match := subjectCodeExpr.FindStringSubmatch(strings.TrimSpace(subject)); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
 func buildPageURL(base string, skip, pageSize int) (string, error) {
+	return buildOffsetURL(base, "skip", "show", skip, pageSize)
+}
+
+func buildOffsetURL(base, skipParam, showParam string, skip, pageSize int) (string, error) {
 	parsed, err := url.Parse(base)
 	if err != nil {
 		return "", fmt.Errorf("invalid category url %s: %w", base, err)
 	}
 
 	query := parsed.Query()
-	query.Set("skip", strconv.Itoa(skip))
-	query.Set("show", strconv.Itoa(pageSize))
+	query.Set(skipParam, strconv.Itoa(skip))
+	query.Set(showParam, strconv.Itoa(pageSize))
 	parsed.RawQuery = query.Encode()
 	return parsed.String(), nil
 }
 
+func formatDays(days []time.Time) []string {
+	formatted := make([]string, len(days))
+	for i, day := range days {
+		formatted[i] = day.Format("2006-01-02")
+	}
+	return formatted
+}
+
 func (a *ArxivScanner) debug(msg string, args ...interface{}) {
 	if a.logger != nil {
 		a.logger.Debug(msg, args...)
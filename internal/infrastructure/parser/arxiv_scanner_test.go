@@ -11,6 +11,7 @@ import (
 
 	"github.com/PuerkitoBio/goquery"
 
+	"ArticlesScanner/internal/domain"
 	"ArticlesScanner/internal/scanner"
 )
 
@@ -88,10 +89,65 @@ func TestParseEntry(t *testing.T) {
 	}
 }
 
+func TestParseEntryListingMetadata(t *testing.T) {
+	t.Parallel()
+
+	html := `
+	<h3>Showing new listings for Friday, 7 November 2025</h3>
+	<dl>
+	  <h3>New submissions (showing 1 of 1 entries)</h3>
+	  <dt><a href="/abs/2511.01234" title="Abstract">arXiv:2511.01234</a> [<a href="https://arxiv.org/html/2511.01234v1">html</a>]</dt>
+	  <dd>
+	    <div class="meta">
+	      <div class="list-title mathjax"><span class="descriptor">Title:</span> Scaling Sparse Models</div>
+	      <div class="list-authors"><a href="/a/lee_a_1">Ann Lee</a>, <a href="/a/chen_b_1">Bo Chen</a></div>
+	      <div class="list-comments mathjax"><span class="descriptor">Comments:</span> Accepted at NeurIPS 2025; 12 pages</div>
+	      <div class="list-journal-ref"><span class="descriptor">Journal-ref:</span> Proc. NeurIPS 38 (2025)</div>
+	      <div class="list-subjects"><span class="descriptor">Subjects:</span>
+	        <span class="primary-subject">Machine Learning (cs.LG)</span>; Artificial Intelligence (cs.AI); Machine Learning (stat.ML)</div>
+	      <p class="mathjax">Sparse abstract.</p>
+	    </div>
+	  </dd>
+	</dl>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		t.Fatalf("new document: %v", err)
+	}
+
+	article, publishedAt, err := parseEntry(doc.Find("dt").First(), doc.Find("dd").First(), "arxiv-ai", "cs.AI")
+	if err != nil {
+		t.Fatalf("parseEntry error: %v", err)
+	}
+	if publishedAt.Format("2006-01-02") != "2025-11-07" {
+		t.Fatalf("expected listing heading date, got %v", publishedAt)
+	}
+	if article.Announcement != domain.AnnouncementNew || article.Version != 1 {
+		t.Fatalf("unexpected announcement: %s v%d", article.Announcement, article.Version)
+	}
+
+	if len(article.Authors) != 2 || article.Authors[0] != "Ann Lee" || article.Authors[1] != "Bo Chen" {
+		t.Fatalf("unexpected authors: %v", article.Authors)
+	}
+	if article.PrimaryCategory != "cs.LG" {
+		t.Fatalf("unexpected primary category: %s", article.PrimaryCategory)
+	}
+	if len(article.SecondaryCategories) != 2 || article.SecondaryCategories[0] != "cs.AI" || article.SecondaryCategories[1] != "stat.ML" {
+		t.Fatalf("unexpected secondary categories: %v", article.SecondaryCategories)
+	}
+	if article.Comments != "Accepted at NeurIPS 2025; 12 pages" {
+		t.Fatalf("unexpected comments: %q", article.Comments)
+	}
+	if article.JournalRef != "Proc. NeurIPS 38 (2025)" {
+		t.Fatalf("unexpected journal ref: %q", article.JournalRef)
+	}
+}
+
 func TestArxivScannerScan(t *testing.T) {
 	t.Parallel()
 
-	targetDay := time.Date(2025, time.November, 8, 0, 0, 0, 0, time.UTC)
+	// Tuesday 01:00 ET: the Tuesday mailing went out Monday evening.
+	runAt := time.Date(2025, time.November, 11, 6, 0, 0, 0, time.UTC)
 
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		_, _ = w.Write([]byte(`
@@ -100,7 +156,7 @@ func TestArxivScannerScan(t *testing.T) {
 		    <span class="list-identifier"><a href="/abs/2501.00001">arXiv:2501.00001</a></span>
 		  </dt>
 		  <dd>
-		    <div class="list-date">Date: 8 Nov 2025</div>
+		    <div class="list-date">Date: 11 Nov 2025</div>
 		    <div class="list-title mathjax">Title: Fresh Article</div>
 		    <p class="mathjax">Abstract: brand new.</p>
 		  </dd>
@@ -108,7 +164,7 @@ func TestArxivScannerScan(t *testing.T) {
 		    <span class="list-identifier"><a href="/abs/2501.00002">arXiv:2501.00002</a></span>
 		  </dt>
 		  <dd>
-		    <div class="list-date">Date: 7 Nov 2025</div>
+		    <div class="list-date">Date: 10 Nov 2025</div>
 		    <div class="list-title mathjax">Title: Old Article</div>
 		    <p class="mathjax">Abstract: older.</p>
 		  </dd>
@@ -117,11 +173,11 @@ func TestArxivScannerScan(t *testing.T) {
 	defer server.Close()
 
 	client := server.Client()
-	sc := NewArxivScanner(client, nil)
+	sc := NewArxivScanner(client, nil, nil)
 	sc.pageSize = 10
 
 	req := scanner.Request{
-		Day:      targetDay,
+		Day:      runAt,
 		SiteName: "arxiv-ai",
 		Categories: []scanner.Category{
 			{Name: "cs.AI", URL: server.URL + "/list/cs.AI"},
@@ -145,3 +201,138 @@ func TestArxivScannerScan(t *testing.T) {
 		t.Fatalf("unexpected abstract: %s", articles[0].Abstract)
 	}
 }
+
+func TestArxivScannerAnnouncementTypes(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`
+		<h3>Showing new listings for Monday, 10 November 2025</h3>
+		<dl id="articles">
+		  <h3>New submissions (showing 1 of 1 entries)</h3>
+		  <dt><a href="/abs/2511.00001">arXiv:2511.00001</a></dt>
+		  <dd><div class="list-title mathjax">Title: Brand New</div></dd>
+		  <h3>Cross submissions (showing 1 of 1 entries)</h3>
+		  <dt><a href="/abs/2511.00002">arXiv:2511.00002</a> (cross-list from cs.CV)</dt>
+		  <dd><div class="list-title mathjax">Title: Crossed</div></dd>
+		  <h3>Replacement submissions (showing 1 of 1 entries)</h3>
+		  <dt><a href="/abs/2409.00003">arXiv:2409.00003</a> (replaced) [<a href="/pdf/2409.00003">pdf</a>, <a href="https://arxiv.org/html/2409.00003v3">html</a>]</dt>
+		  <dd><div class="list-title mathjax">Title: Revised</div></dd>
+		</dl>`))
+	}))
+	defer server.Close()
+
+	sc := NewArxivScanner(server.Client(), nil, nil)
+	sc.pageSize = 10
+	req := scanner.Request{
+		Day:        time.Date(2025, time.November, 10, 6, 0, 0, 0, time.UTC),
+		SiteName:   "arxiv-ai",
+		Categories: []scanner.Category{{Name: "cs.AI", URL: server.URL + "/list/cs.AI/new"}},
+	}
+
+	articles, err := sc.Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+	if len(articles) != 3 {
+		t.Fatalf("expected 3 articles, got %d", len(articles))
+	}
+	if articles[1].Announcement != domain.AnnouncementCrossList || articles[1].Version != 0 {
+		t.Fatalf("unexpected cross-list: %s v%d", articles[1].Announcement, articles[1].Version)
+	}
+	if articles[2].Announcement != domain.AnnouncementReplacement || articles[2].Version != 3 {
+		t.Fatalf("unexpected replacement: %s v%d", articles[2].Announcement, articles[2].Version)
+	}
+
+	req.Options = map[string]string{"includeCrossLists": "false", "includeReplacements": "false"}
+	articles, err = sc.Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+	if len(articles) != 1 || articles[0].ID != "arXiv:2511.00001" {
+		t.Fatalf("expected only the new submission, got %+v", articles)
+	}
+}
+
+func TestArxivScannerParallelCategories(t *testing.T) {
+	t.Parallel()
+
+	listing := func(ids ...string) string {
+		var b strings.Builder
+		b.WriteString("<dl>")
+		for _, id := range ids {
+			b.WriteString(`<dt><a href="/abs/` + id + `">arXiv:` + id + `</a></dt><dd><div class="list-date">Date: 11 Nov 2025</div><div class="list-title mathjax">Title: ` + id + `</div></dd>`)
+		}
+		b.WriteString("</dl>")
+		return b.String()
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.Contains(r.URL.Path, "cs.AI"):
+			time.Sleep(20 * time.Millisecond)
+			_, _ = w.Write([]byte(listing("2511.00001", "2511.00002")))
+		default:
+			_, _ = w.Write([]byte(listing("2511.00002", "2511.00003")))
+		}
+	}))
+	defer server.Close()
+
+	sc := NewArxivScanner(server.Client(), nil, nil)
+	sc.pageSize = 10
+	req := scanner.Request{
+		Day:      time.Date(2025, time.November, 11, 6, 0, 0, 0, time.UTC),
+		SiteName: "arxiv",
+		Categories: []scanner.Category{
+			{Name: "cs.AI", URL: server.URL + "/list/cs.AI"},
+			{Name: "cs.LG", URL: server.URL + "/list/cs.LG"},
+		},
+		Workers: 2,
+	}
+
+	articles, err := sc.Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+	var ids []string
+	for _, article := range articles {
+		ids = append(ids, article.ID)
+	}
+	if got := strings.Join(ids, ","); got != "arXiv:2511.00001,arXiv:2511.00002,arXiv:2511.00003" {
+		t.Fatalf("unexpected order: %s", got)
+	}
+}
+
+func TestArxivScannerReportsEveryFailedCategory(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "cs.AI") {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	sc := NewArxivScanner(server.Client(), nil, nil)
+	req := scanner.Request{
+		Day:      time.Date(2025, time.November, 11, 6, 0, 0, 0, time.UTC),
+		SiteName: "arxiv",
+		Categories: []scanner.Category{
+			{Name: "cs.AI", URL: server.URL + "/list/cs.AI"},
+			{Name: "cs.LG", URL: server.URL + "/list/cs.LG"},
+		},
+		Workers: 2,
+	}
+
+	_, err := sc.Scan(context.Background(), req)
+	if err == nil {
+		t.Fatal("expected an error when every category fails")
+	}
+	for _, want := range []string{"category cs.AI", "503", "category cs.LG", "404"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("expected %q in %q", want, err)
+		}
+	}
+}
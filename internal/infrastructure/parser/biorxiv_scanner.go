@@ -0,0 +1,190 @@
+package parser
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/scanner"
+)
+
+const (
+	biorxivEndpoint = "https://api.biorxiv.org"
+	biorxivServer   = "biorxiv"
+)
+
+var biorxivContentHosts = map[string]string{
+	"biorxiv": "https://www.biorxiv.org",
+	"medrxiv": "https://www.medrxiv.org",
+}
+
+// BiorxivScanner pages through the bioRxiv/medRxiv details API for a single day.
+type BiorxivScanner struct {
+	client *http.Client
+	logger *slog.Logger
+}
+
+// NewBiorxivScanner wires an HTTP client; nil falls back to a client with a sane timeout.
+func NewBiorxivScanner(client *http.Client, log *slog.Logger) *BiorxivScanner {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &BiorxivScanner{client: client, logger: log}
+}
+
+// Name identifies the strategy inside the registry.
+func (b *BiorxivScanner) Name() string {
+	return "biorxiv"
+}
+
+// Scan collects preprints posted on the requested day, keeping only configured subject categories.
+//
+// Options: "server" selects biorxiv (default) or medrxiv, "endpoint" overrides the API host.
+// Category names are subject categories (e.g. bioinformatics); no categories means every subject.
+func (b *BiorxivScanner) Scan(ctx context.Context, req scanner.Request) ([]domain.Article, error) {
+	server := strings.ToLower(optionOr(req.Options, "server", biorxivServer))
+	contentHost, ok := biorxivContentHosts[server]
+	if !ok {
+		return nil, fmt.Errorf("unsupported server %s", server)
+	}
+	endpoint := strings.TrimSuffix(optionOr(req.Options, "endpoint", biorxivEndpoint), "/")
+	day := req.Day.Format("2006-01-02")
+
+	subjects := map[string]string{}
+	for _, cat := range req.Categories {
+		subjects[normalizeSubject(cat.Name)] = cat.Name
+	}
+
+	b.debug("scan start", "site", req.SiteName, "server", server, "subjects", len(subjects), "target_day", day)
+
+	results := make([]domain.Article, 0)
+	seen := map[string]struct{}{}
+	cursor := 0
+	for {
+		pageURL := fmt.Sprintf("%s/details/%s/%s/%s/%d/json", endpoint, server, day, day, cursor)
+		b.debug("requesting", "url", pageURL)
+
+		body, err := fetchBody(ctx, b.client, pageURL, nil)
+		if err != nil {
+			return nil, fmt.Errorf("details cursor %d: %w", cursor, err)
+		}
+
+		var page biorxivResponse
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, fmt.Errorf("decode details: %w", err)
+		}
+		if len(page.Messages) > 0 && page.Messages[0].Status != "ok" && len(page.Collection) == 0 {
+			// "no posts found" is reported as a non-ok status rather than an empty collection.
+			b.debug("no more records", "cursor", cursor, "status", page.Messages[0].Status)
+			break
+		}
+
+		for _, item := range page.Collection {
+			label := ""
+			if len(subjects) > 0 {
+				var ok bool
+				if label, ok = subjects[normalizeSubject(item.Category)]; !ok {
+					continue
+				}
+			}
+			article := item.toArticle(contentHost, articleSource(req.SiteName, label))
+			if _, ok := seen[article.ID]; ok {
+				continue
+			}
+			seen[article.ID] = struct{}{}
+			results = append(results, article)
+		}
+
+		cursor += len(page.Collection)
+		if len(page.Collection) == 0 || len(page.Messages) == 0 || cursor >= int(page.Messages[0].Total) {
+			break
+		}
+	}
+
+	b.debug("scan finished", "site", req.SiteName, "total", len(results))
+	return results, nil
+}
+
+// normalizeSubject lets "Cell Biology" in config match "cell biology" or "cell_biology" from the API.
+func normalizeSubject(subject string) string {
+	return strings.Join(strings.Fields(strings.ReplaceAll(strings.ToLower(subject), "_", " ")), " ")
+}
+
+type biorxivResponse struct {
+	Messages []struct {
+		Status string  `json:"status"`
+		Total  flexInt `json:"total"`
+	} `json:"messages"`
+	Collection []biorxivItem `json:"collection"`
+}
+
+type biorxivItem struct {
+	DOI      string `json:"doi"`
+	Title    string `json:"title"`
+	Authors  string `json:"authors"`
+	Date     string `json:"date"`
+	Version  string `json:"version"`
+	Category string `json:"category"`
+	Abstract string `json:"abstract"`
+	Server   string `json:"server"`
+}
+
+func (i biorxivItem) toArticle(contentHost, source string) domain.Article {
+	doi := strings.TrimSpace(i.DOI)
+	version := strings.TrimSpace(i.Version)
+	if version == "" {
+		version = "1"
+	}
+
+	var authors []string
+	for _, name := range strings.Split(i.Authors, ";") {
+		if name = collapseSpaces(name); name != "" {
+			authors = append(authors, name)
+		}
+	}
+
+	published, _ := time.Parse("2006-01-02", strings.TrimSpace(i.Date))
+
+	return domain.Article{
+		ID:              doiArticleID(doi) + "v" + version,
+		Title:           collapseSpaces(i.Title),
+		Abstract:        collapseSpaces(i.Abstract),
+		URL:             fmt.Sprintf("%s/content/%sv%s", contentHost, doi, version),
+		Source:          source,
+		Authors:         authors,
+		PrimaryCategory: i.Category,
+		DOI:             doi,
+		Venue:           i.Server,
+		PublishedAt:     published,
+	}
+}
+
+// flexInt accepts counters the API emits either as JSON numbers or numeric strings.
+type flexInt int
+
+func (f *flexInt) UnmarshalJSON(data []byte) error {
+	data = bytes.Trim(data, `"`)
+	if len(data) == 0 || string(data) == "null" {
+		*f = 0
+		return nil
+	}
+	v, err := strconv.Atoi(string(data))
+	if err != nil {
+		return fmt.Errorf("parse counter %s: %w", data, err)
+	}
+	*f = flexInt(v)
+	return nil
+}
+
+func (b *BiorxivScanner) debug(msg string, args ...interface{}) {
+	if b.logger != nil {
+		b.logger.Debug(msg, args...)
+	}
+}
@@ -0,0 +1,96 @@
+package parser
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"ArticlesScanner/internal/scanner"
+)
+
+func TestBiorxivScannerScan(t *testing.T) {
+	t.Parallel()
+
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/details/medrxiv/2025-11-08/2025-11-08/0/json":
+			_, _ = w.Write([]byte(`{
+			  "messages":[{"status":"ok","cursor":0,"count":2,"total":"3"}],
+			  "collection":[
+			    {"doi":"10.1101/2025.11.06.001","title":"Vaccine trial","authors":"Doe, J.; Roe, R.","date":"2025-11-08","version":"2","category":"infectious diseases","abstract":"Trial.","server":"medRxiv"},
+			    {"doi":"10.1101/2025.11.06.002","title":"Off topic","authors":"X, Y.","date":"2025-11-08","version":"1","category":"dermatology","abstract":"Skin.","server":"medRxiv"}
+			  ]}`))
+		case "/details/medrxiv/2025-11-08/2025-11-08/2/json":
+			_, _ = w.Write([]byte(`{
+			  "messages":[{"status":"ok","cursor":2,"count":1,"total":3}],
+			  "collection":[
+			    {"doi":"10.1101/2025.11.06.003","title":"Cohort","authors":"Z, Q.","date":"2025-11-08","version":"1","category":"Epidemiology","abstract":"Cohort.","server":"medRxiv"}
+			  ]}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	req := scanner.Request{
+		Day:      time.Date(2025, time.November, 8, 6, 0, 0, 0, time.UTC),
+		SiteName: "medrxiv",
+		Options:  map[string]string{"server": "medrxiv", "endpoint": server.URL},
+		Categories: []scanner.Category{
+			{Name: "Infectious_Diseases"},
+			{Name: "epidemiology"},
+		},
+	}
+
+	articles, err := NewBiorxivScanner(server.Client(), nil).Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+
+	if len(paths) != 2 {
+		t.Fatalf("expected 2 pages, got %v", paths)
+	}
+	if len(articles) != 2 {
+		t.Fatalf("expected 2 articles, got %d", len(articles))
+	}
+
+	first := articles[0]
+	if first.ID != "doi:10.1101/2025.11.06.001v2" {
+		t.Fatalf("unexpected id: %s", first.ID)
+	}
+	if first.URL != "https://www.medrxiv.org/content/10.1101/2025.11.06.001v2" {
+		t.Fatalf("unexpected url: %s", first.URL)
+	}
+	if first.Source != "medrxiv/Infectious_Diseases" || len(first.Authors) != 2 {
+		t.Fatalf("unexpected source/authors: %s %v", first.Source, first.Authors)
+	}
+	if articles[1].Source != "medrxiv/epidemiology" {
+		t.Fatalf("unexpected second source: %s", articles[1].Source)
+	}
+}
+
+func TestBiorxivScannerNoPosts(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"messages":[{"status":"no posts found"}],"collection":[]}`))
+	}))
+	defer server.Close()
+
+	articles, err := NewBiorxivScanner(server.Client(), nil).Scan(context.Background(), scanner.Request{
+		Day:      time.Date(2025, time.November, 9, 0, 0, 0, 0, time.UTC),
+		SiteName: "biorxiv",
+		Options:  map[string]string{"endpoint": server.URL},
+	})
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+	if len(articles) != 0 {
+		t.Fatalf("expected no articles, got %d", len(articles))
+	}
+}
@@ -0,0 +1,41 @@
+package parser
+
+import (
+	"context"
+	"sync"
+)
+
+// runLimited calls fn for indexes 0..count-1 on at most workers goroutines (sequentially when
+// workers <= 1) and returns the errors by index so callers can report them in config order.
+// Indexes not yet started when ctx ends get ctx.Err().
+func runLimited(ctx context.Context, workers, count int, fn func(ctx context.Context, i int) error) []error {
+	errs := make([]error, count)
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > count {
+		workers = count
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := ctx.Err(); err != nil {
+					errs[i] = err
+					continue
+				}
+				errs[i] = fn(ctx, i)
+			}
+		}()
+	}
+	for i := 0; i < count; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return errs
+}
@@ -0,0 +1,242 @@
+package parser
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/scanner"
+)
+
+const (
+	crossrefEndpoint = "https://api.crossref.org"
+	crossrefRows     = 200
+	crossrefSelect   = "DOI,title,abstract,author,container-title,published,issued,URL"
+)
+
+var (
+	issnExpr      = regexp.MustCompile(`^\d{4}-\d{3}[\dXx]$`)
+	jatsTitleExpr = regexp.MustCompile(`(?s)<jats:title>.*?</jats:title>`)
+)
+
+// CrossrefScanner lists journal works from the Crossref REST API with cursor deep paging.
+type CrossrefScanner struct {
+	client *http.Client
+	rows   int
+	logger *slog.Logger
+}
+
+// NewCrossrefScanner wires an HTTP client; pages hold 200 rows.
+func NewCrossrefScanner(client *http.Client, log *slog.Logger) *CrossrefScanner {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &CrossrefScanner{client: client, rows: crossrefRows, logger: log}
+}
+
+// Name identifies the strategy inside the registry.
+func (c *CrossrefScanner) Name() string {
+	return "crossref"
+}
+
+// Scan queries /works for every category URL holding an ISSN (1476-4687) or member ID (member:78).
+//
+// Option "dateFilter" picks pub (from/until-pub-date, default) or index (from/until-index-date);
+// "mailto" joins the polite pool and "endpoint" overrides the API host.
+func (c *CrossrefScanner) Scan(ctx context.Context, req scanner.Request) ([]domain.Article, error) {
+	if len(req.Categories) == 0 {
+		return nil, fmt.Errorf("no categories provided for site %s", req.SiteName)
+	}
+
+	dateFilter := optionOr(req.Options, "dateFilter", "pub")
+	if dateFilter != "pub" && dateFilter != "index" {
+		return nil, fmt.Errorf("unsupported dateFilter %s", dateFilter)
+	}
+	endpoint := strings.TrimSuffix(optionOr(req.Options, "endpoint", crossrefEndpoint), "/")
+	day := req.Day.Format("2006-01-02")
+
+	c.debug("scan start", "site", req.SiteName, "sources", len(req.Categories), "target_day", day)
+
+	results := make([]domain.Article, 0)
+	seen := map[string]struct{}{}
+	for _, cat := range req.Categories {
+		scope, err := crossrefScope(cat.URL)
+		if err != nil {
+			return nil, fmt.Errorf("category %s: %w", cat.Name, err)
+		}
+		filter := fmt.Sprintf("%s,from-%s-date:%s,until-%s-date:%s", scope, dateFilter, day, dateFilter, day)
+
+		articles, err := c.listWorks(ctx, endpoint, filter, req.Options["mailto"], req.Day.Location(), articleSource(req.SiteName, cat.Name))
+		if err != nil {
+			return nil, fmt.Errorf("category %s: %w", cat.Name, err)
+		}
+		for _, article := range articles {
+			if _, ok := seen[article.ID]; ok {
+				continue
+			}
+			seen[article.ID] = struct{}{}
+			results = append(results, article)
+		}
+	}
+
+	c.debug("scan finished", "site", req.SiteName, "total", len(results))
+	return results, nil
+}
+
+// crossrefScope turns a category URL into the issn:/member: filter clause.
+func crossrefScope(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	switch {
+	case strings.HasPrefix(value, "member:"):
+		if _, err := strconv.Atoi(strings.TrimPrefix(value, "member:")); err != nil {
+			return "", fmt.Errorf("invalid member id %s", value)
+		}
+		return value, nil
+	case issnExpr.MatchString(strings.TrimPrefix(value, "issn:")):
+		return "issn:" + strings.ToUpper(strings.TrimPrefix(value, "issn:")), nil
+	default:
+		return "", fmt.Errorf("url must be an ISSN or member:<id>, got %q", value)
+	}
+}
+
+func (c *CrossrefScanner) listWorks(ctx context.Context, endpoint, filter, mailto string, loc *time.Location, source string) ([]domain.Article, error) {
+	var collected []domain.Article
+	cursor := "*"
+	for {
+		query := url.Values{}
+		query.Set("filter", filter)
+		query.Set("rows", strconv.Itoa(c.rows))
+		query.Set("cursor", cursor)
+		query.Set("select", crossrefSelect)
+		if mailto != "" {
+			query.Set("mailto", mailto)
+		}
+
+		pageURL := endpoint + "/works?" + query.Encode()
+		c.debug("requesting", "url", pageURL)
+
+		body, err := fetchBody(ctx, c.client, pageURL, http.Header{"Accept": {"application/json"}})
+		if err != nil {
+			return nil, fmt.Errorf("list works: %w", err)
+		}
+
+		var resp struct {
+			Message struct {
+				NextCursor string         `json:"next-cursor"`
+				Items      []crossrefWork `json:"items"`
+			} `json:"message"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("decode works: %w", err)
+		}
+
+		for _, work := range resp.Message.Items {
+			if article, ok := work.toArticle(loc, source); ok {
+				collected = append(collected, article)
+			}
+		}
+		c.debug("page processed", "filter", filter, "items", len(resp.Message.Items), "collected", len(collected))
+
+		next := resp.Message.NextCursor
+		if len(resp.Message.Items) < c.rows || next == "" || next == cursor {
+			return collected, nil
+		}
+		cursor = next
+	}
+}
+
+type crossrefWork struct {
+	DOI            string   `json:"DOI"`
+	Title          []string `json:"title"`
+	Abstract       string   `json:"abstract"`
+	ContainerTitle []string `json:"container-title"`
+	URL            string   `json:"URL"`
+	Author         []struct {
+		Given  string `json:"given"`
+		Family string `json:"family"`
+		Name   string `json:"name"`
+	} `json:"author"`
+	Published crossrefDate `json:"published"`
+	Issued    crossrefDate `json:"issued"`
+}
+
+type crossrefDate struct {
+	DateParts [][]int `json:"date-parts"`
+}
+
+// time converts Crossref date-parts ([[2025, 11, 8]], month/day optional) into a day in loc.
+func (d crossrefDate) time(loc *time.Location) (time.Time, bool) {
+	if len(d.DateParts) == 0 || len(d.DateParts[0]) == 0 || d.DateParts[0][0] == 0 {
+		return time.Time{}, false
+	}
+	parts := append(append([]int(nil), d.DateParts[0]...), 1, 1)
+	return time.Date(parts[0], time.Month(parts[1]), parts[2], 0, 0, 0, 0, loc), true
+}
+
+func (w crossrefWork) toArticle(loc *time.Location, source string) (domain.Article, bool) {
+	doi := strings.TrimSpace(w.DOI)
+	if doi == "" {
+		return domain.Article{}, false
+	}
+
+	var title string
+	if len(w.Title) > 0 {
+		title = htmlText(w.Title[0])
+	}
+	var venue string
+	if len(w.ContainerTitle) > 0 {
+		venue = collapseSpaces(w.ContainerTitle[0])
+	}
+
+	authors := make([]string, 0, len(w.Author))
+	for _, author := range w.Author {
+		name := strings.TrimSpace(author.Given + " " + author.Family)
+		if name == "" {
+			name = strings.TrimSpace(author.Name)
+		}
+		if name != "" {
+			authors = append(authors, name)
+		}
+	}
+
+	publishedAt, ok := w.Published.time(loc)
+	if !ok {
+		publishedAt, _ = w.Issued.time(loc)
+	}
+
+	link := strings.TrimSpace(w.URL)
+	if link == "" {
+		link = "https://doi.org/" + doi
+	}
+
+	return domain.Article{
+		ID:          doiArticleID(doi),
+		Title:       title,
+		Abstract:    jatsText(w.Abstract),
+		URL:         link,
+		Source:      source,
+		Authors:     authors,
+		DOI:         doi,
+		Venue:       venue,
+		PublishedAt: publishedAt,
+	}, true
+}
+
+// jatsText strips JATS markup (and the redundant "Abstract" heading) down to plain text.
+func jatsText(value string) string {
+	return htmlText(jatsTitleExpr.ReplaceAllString(value, " "))
+}
+
+func (c *CrossrefScanner) debug(msg string, args ...interface{}) {
+	if c.logger != nil {
+		c.logger.Debug(msg, args...)
+	}
+}
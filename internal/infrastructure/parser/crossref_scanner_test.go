@@ -0,0 +1,100 @@
+package parser
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"ArticlesScanner/internal/scanner"
+)
+
+func TestCrossrefScope(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"1476-4687":      "issn:1476-4687",
+		"issn:0036-807x": "issn:0036-807X",
+		"member:78":      "member:78",
+	}
+	for input, want := range cases {
+		got, err := crossrefScope(input)
+		if err != nil || got != want {
+			t.Fatalf("crossrefScope(%s) = %s, %v; want %s", input, got, err, want)
+		}
+	}
+	if _, err := crossrefScope("https://nature.com"); err == nil {
+		t.Fatal("expected error for non-ISSN url")
+	}
+}
+
+func TestCrossrefScannerScan(t *testing.T) {
+	t.Parallel()
+
+	var cursors []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/works" || q.Get("filter") != "issn:1476-4687,from-index-date:2025-11-08,until-index-date:2025-11-08" {
+			t.Errorf("unexpected request: %s", r.URL.String())
+		}
+		if q.Get("mailto") != "ops@example.org" {
+			t.Errorf("missing mailto")
+		}
+		cursors = append(cursors, q.Get("cursor"))
+
+		switch q.Get("cursor") {
+		case "*":
+			_, _ = w.Write([]byte(`{"status":"ok","message":{"next-cursor":"c2","items":[
+			  {"DOI":"10.1038/S41586-025-1","title":["Quantum <i>advantage</i>"],
+			   "abstract":"<jats:title>Abstract</jats:title><jats:p>We show <jats:italic>speedups</jats:italic>.</jats:p>",
+			   "author":[{"given":"Ann","family":"Lee"},{"name":"Consortium"}],
+			   "container-title":["Nature"],"published":{"date-parts":[[2025,11,8]]},
+			   "URL":"https://doi.org/10.1038/s41586-025-1"},
+			  {"DOI":"10.1038/s41586-025-2","title":["Second"],"issued":{"date-parts":[[2025,11]]}}
+			]}}`))
+		case "c2":
+			_, _ = w.Write([]byte(`{"status":"ok","message":{"next-cursor":"c3","items":[
+			  {"DOI":"10.1038/s41586-025-3","title":["Third"]}
+			]}}`))
+		default:
+			t.Errorf("unexpected cursor %s", q.Get("cursor"))
+		}
+	}))
+	defer server.Close()
+
+	sc := NewCrossrefScanner(server.Client(), nil)
+	sc.rows = 2
+
+	req := scanner.Request{
+		Day:        time.Date(2025, time.November, 8, 6, 0, 0, 0, time.UTC),
+		SiteName:   "journals",
+		Options:    map[string]string{"endpoint": server.URL, "dateFilter": "index", "mailto": "ops@example.org"},
+		Categories: []scanner.Category{{Name: "nature", URL: "1476-4687"}},
+	}
+
+	articles, err := sc.Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+	if len(cursors) != 2 || cursors[1] != "c2" {
+		t.Fatalf("unexpected cursors: %v", cursors)
+	}
+	if len(articles) != 3 {
+		t.Fatalf("expected 3 articles, got %d", len(articles))
+	}
+
+	first := articles[0]
+	if first.ID != "doi:10.1038/s41586-025-1" || first.Venue != "Nature" {
+		t.Fatalf("unexpected identity/venue: %s %s", first.ID, first.Venue)
+	}
+	if first.Title != "Quantum advantage" || first.Abstract != "We show speedups." {
+		t.Fatalf("unexpected text: %q %q", first.Title, first.Abstract)
+	}
+	if len(first.Authors) != 2 || first.Authors[0] != "Ann Lee" {
+		t.Fatalf("unexpected authors: %v", first.Authors)
+	}
+	if articles[1].PublishedAt.Month() != time.November || articles[2].URL != "https://doi.org/10.1038/s41586-025-3" {
+		t.Fatalf("unexpected fallbacks: %+v %+v", articles[1], articles[2])
+	}
+}
@@ -0,0 +1,218 @@
+package parser
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/scanner"
+)
+
+const (
+	dblpEndpoint = "https://dblp.org"
+	dblpPageSize = 1000
+	// dblpMaxOffset mirrors the search API limit on the f parameter.
+	dblpMaxOffset = 10000
+)
+
+// DBLPScanner lists publications from the DBLP search API, usually one proceedings table of contents per category.
+type DBLPScanner struct {
+	client   *http.Client
+	pageSize int
+	logger   *slog.Logger
+}
+
+// NewDBLPScanner wires an HTTP client; pages hold the API maximum of 1000 hits.
+func NewDBLPScanner(client *http.Client, log *slog.Logger) *DBLPScanner {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &DBLPScanner{client: client, pageSize: dblpPageSize, logger: log}
+}
+
+// Name identifies the strategy inside the registry.
+func (d *DBLPScanner) Name() string {
+	return "dblp"
+}
+
+// Scan runs the query derived from each category URL and returns every hit keyed by its DBLP record key.
+//
+// The URL is a table-of-contents path (db/conf/nips/neurips2023) or a raw search query
+// (stream:conf/iclr: year:2024). DBLP only exposes publication years, so all hits are returned and storage
+// dedup suppresses repeats. Option "endpoint" overrides the API host.
+func (d *DBLPScanner) Scan(ctx context.Context, req scanner.Request) ([]domain.Article, error) {
+	if len(req.Categories) == 0 {
+		return nil, fmt.Errorf("no categories provided for site %s", req.SiteName)
+	}
+	endpoint := strings.TrimSuffix(optionOr(req.Options, "endpoint", dblpEndpoint), "/")
+
+	d.debug("scan start", "site", req.SiteName, "queries", len(req.Categories), "target_day", req.Day.Format("2006-01-02"))
+
+	results := make([]domain.Article, 0)
+	seen := map[string]struct{}{}
+	for _, cat := range req.Categories {
+		query := dblpQuery(cat.URL)
+		if query == "" {
+			return nil, fmt.Errorf("category %s: table of contents or query is required in url", cat.Name)
+		}
+
+		articles, err := d.search(ctx, endpoint, query, req.Day.Location(), articleSource(req.SiteName, cat.Name))
+		if err != nil {
+			return nil, fmt.Errorf("category %s: %w", cat.Name, err)
+		}
+		for _, article := range articles {
+			if _, ok := seen[article.ID]; ok {
+				continue
+			}
+			seen[article.ID] = struct{}{}
+			results = append(results, article)
+		}
+	}
+
+	d.debug("scan finished", "site", req.SiteName, "total", len(results))
+	return results, nil
+}
+
+// dblpQuery turns a TOC path such as db/conf/acl/acl2024 into toc:db/conf/acl/acl2024.bht: and passes queries through.
+func dblpQuery(value string) string {
+	value = strings.TrimSpace(value)
+	value = strings.TrimPrefix(value, "https://dblp.org/")
+	if !strings.HasPrefix(value, "db/") {
+		return value
+	}
+	value = strings.TrimSuffix(strings.TrimSuffix(value, ".html"), ".bht")
+	return "toc:" + value + ".bht:"
+}
+
+func (d *DBLPScanner) search(ctx context.Context, endpoint, query string, loc *time.Location, source string) ([]domain.Article, error) {
+	var collected []domain.Article
+	for first := 0; first < dblpMaxOffset; first += d.pageSize {
+		params := url.Values{}
+		params.Set("q", query)
+		params.Set("format", "json")
+		params.Set("h", strconv.Itoa(d.pageSize))
+		params.Set("f", strconv.Itoa(first))
+
+		pageURL := endpoint + "/search/publ/api?" + params.Encode()
+		d.debug("requesting", "url", pageURL)
+
+		body, err := fetchBody(ctx, d.client, pageURL, http.Header{"Accept": {"application/json"}})
+		if err != nil {
+			return nil, fmt.Errorf("search: %w", err)
+		}
+
+		var resp struct {
+			Result struct {
+				Hits struct {
+					Total flexInt   `json:"@total"`
+					Hit   []dblpHit `json:"hit"`
+				} `json:"hits"`
+			} `json:"result"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("decode search: %w", err)
+		}
+
+		hits := resp.Result.Hits.Hit
+		for _, hit := range hits {
+			if article, ok := hit.Info.toArticle(loc, source); ok {
+				collected = append(collected, article)
+			}
+		}
+		d.debug("page processed", "query", query, "first", first, "hits", len(hits), "total", int(resp.Result.Hits.Total))
+
+		if len(hits) < d.pageSize || first+len(hits) >= int(resp.Result.Hits.Total) {
+			break
+		}
+	}
+	return collected, nil
+}
+
+type dblpHit struct {
+	Info dblpInfo `json:"info"`
+}
+
+type dblpInfo struct {
+	Key     string `json:"key"`
+	Title   string `json:"title"`
+	Authors struct {
+		Author oneOrMany[dblpAuthor] `json:"author"`
+	} `json:"authors"`
+	Venue oneOrMany[string] `json:"venue"`
+	Year  string            `json:"year"`
+	DOI   string            `json:"doi"`
+	EE    oneOrMany[string] `json:"ee"`
+	URL   string            `json:"url"`
+}
+
+type dblpAuthor struct {
+	Text string `json:"text"`
+}
+
+// oneOrMany decodes fields DBLP emits as a single value or as an array depending on cardinality.
+type oneOrMany[T any] []T
+
+func (o *oneOrMany[T]) UnmarshalJSON(data []byte) error {
+	if trimmed := strings.TrimSpace(string(data)); strings.HasPrefix(trimmed, "[") {
+		var many []T
+		if err := json.Unmarshal(data, &many); err != nil {
+			return err
+		}
+		*o = many
+		return nil
+	}
+	var one T
+	if err := json.Unmarshal(data, &one); err != nil {
+		return err
+	}
+	*o = oneOrMany[T]{one}
+	return nil
+}
+
+func (i dblpInfo) toArticle(loc *time.Location, source string) (domain.Article, bool) {
+	key := strings.TrimSpace(i.Key)
+	if key == "" {
+		return domain.Article{}, false
+	}
+
+	authors := make([]string, 0, len(i.Authors.Author))
+	for _, author := range i.Authors.Author {
+		if name := collapseSpaces(author.Text); name != "" {
+			authors = append(authors, name)
+		}
+	}
+
+	link := strings.TrimSpace(i.URL)
+	if len(i.EE) > 0 && strings.TrimSpace(i.EE[0]) != "" {
+		link = strings.TrimSpace(i.EE[0])
+	}
+
+	var publishedAt time.Time
+	if year, err := strconv.Atoi(i.Year); err == nil {
+		publishedAt = time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	}
+
+	return domain.Article{
+		ID:          "dblp:" + key,
+		Title:       strings.TrimSuffix(htmlText(i.Title), "."),
+		URL:         link,
+		Source:      source,
+		Authors:     authors,
+		DOI:         strings.TrimSpace(i.DOI),
+		Venue:       strings.Join(i.Venue, ", "),
+		PublishedAt: publishedAt,
+	}, true
+}
+
+func (d *DBLPScanner) debug(msg string, args ...interface{}) {
+	if d.logger != nil {
+		d.logger.Debug(msg, args...)
+	}
+}
@@ -0,0 +1,92 @@
+package parser
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"ArticlesScanner/internal/scanner"
+)
+
+func TestDBLPQuery(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"db/conf/nips/neurips2023":                  "toc:db/conf/nips/neurips2023.bht:",
+		"https://dblp.org/db/conf/acl/acl2024.html": "toc:db/conf/acl/acl2024.bht:",
+		"stream:conf/iclr: year:2024":               "stream:conf/iclr: year:2024",
+	}
+	for input, want := range cases {
+		if got := dblpQuery(input); got != want {
+			t.Fatalf("dblpQuery(%s) = %s; want %s", input, got, want)
+		}
+	}
+}
+
+func TestDBLPScannerScan(t *testing.T) {
+	t.Parallel()
+
+	var offsets []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/search/publ/api" || q.Get("q") != "toc:db/conf/nips/neurips2023.bht:" || q.Get("format") != "json" {
+			t.Errorf("unexpected request: %s", r.URL.String())
+		}
+		offsets = append(offsets, q.Get("f"))
+
+		switch q.Get("f") {
+		case "0":
+			_, _ = w.Write([]byte(`{"result":{"hits":{"@total":"3","@sent":"2","@first":"0","hit":[
+			  {"@id":"1","info":{"authors":{"author":[{"@pid":"1","text":"Ann Lee"},{"@pid":"2","text":"Bo Chen"}]},
+			   "title":"Scaling Laws.","venue":"NeurIPS","year":"2023","key":"conf/nips/LeeC23",
+			   "doi":"10.5555/1","ee":["https://proceedings.neurips.cc/1","https://doi.org/1"],"url":"https://dblp.org/rec/conf/nips/LeeC23"}},
+			  {"@id":"2","info":{"authors":{"author":{"@pid":"3","text":"Solo Author"}},
+			   "title":"Single.","venue":["NeurIPS","Workshop"],"year":"2023","key":"conf/nips/Author23","ee":"https://example.org/2"}}
+			]}}}`))
+		case "2":
+			_, _ = w.Write([]byte(`{"result":{"hits":{"@total":"3","@sent":"1","@first":"2","hit":[
+			  {"@id":"3","info":{"title":"Third.","year":"2023","key":"conf/nips/X23","url":"https://dblp.org/rec/conf/nips/X23"}}
+			]}}}`))
+		default:
+			t.Errorf("unexpected offset %s", q.Get("f"))
+		}
+	}))
+	defer server.Close()
+
+	sc := NewDBLPScanner(server.Client(), nil)
+	sc.pageSize = 2
+
+	req := scanner.Request{
+		Day:        time.Date(2025, time.November, 8, 6, 0, 0, 0, time.UTC),
+		SiteName:   "dblp",
+		Options:    map[string]string{"endpoint": server.URL},
+		Categories: []scanner.Category{{Name: "neurips-2023", URL: "db/conf/nips/neurips2023"}},
+	}
+
+	articles, err := sc.Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+	if len(offsets) != 2 || offsets[1] != "2" {
+		t.Fatalf("unexpected offsets: %v", offsets)
+	}
+	if len(articles) != 3 {
+		t.Fatalf("expected 3 articles, got %d", len(articles))
+	}
+
+	first := articles[0]
+	if first.ID != "dblp:conf/nips/LeeC23" || first.Title != "Scaling Laws" || first.URL != "https://proceedings.neurips.cc/1" {
+		t.Fatalf("unexpected first article: %+v", first)
+	}
+	if len(first.Authors) != 2 || first.DOI != "10.5555/1" || first.PublishedAt.Year() != 2023 {
+		t.Fatalf("unexpected metadata: %+v", first)
+	}
+	if second := articles[1]; len(second.Authors) != 1 || second.Venue != "NeurIPS, Workshop" || second.URL != "https://example.org/2" {
+		t.Fatalf("unexpected single-valued decoding: %+v", second)
+	}
+	if articles[2].URL != "https://dblp.org/rec/conf/nips/X23" {
+		t.Fatalf("expected record url fallback, got %s", articles[2].URL)
+	}
+}
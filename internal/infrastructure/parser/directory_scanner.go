@@ -0,0 +1,142 @@
+package parser
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/scanner"
+)
+
+// DirectoryScanner ingests BibTeX, RIS and JSON reference files dropped into a folder.
+type DirectoryScanner struct {
+	logger *slog.Logger
+}
+
+// NewDirectoryScanner builds a scanner over local drop folders.
+func NewDirectoryScanner(log *slog.Logger) *DirectoryScanner {
+	return &DirectoryScanner{logger: log}
+}
+
+// Name identifies the strategy inside the registry.
+func (d *DirectoryScanner) Name() string {
+	return "directory"
+}
+
+// Scan parses every *.bib, *.bibtex, *.ris and *.json file in the folders and relies on storage dedup
+// to skip delivered entries, so files dropped after a day's run are picked up by the next one.
+//
+// Each category URL is a folder; without categories option "path" is used. Option "allFiles" = "false"
+// reads only files modified on the requested day.
+func (d *DirectoryScanner) Scan(ctx context.Context, req scanner.Request) ([]domain.Article, error) {
+	sources := req.Categories
+	if len(sources) == 0 {
+		path := strings.TrimSpace(req.Options["path"])
+		if path == "" {
+			return nil, fmt.Errorf("no directory path provided for site %s", req.SiteName)
+		}
+		sources = []scanner.Category{{Name: "inbox", URL: path}}
+	}
+	allFiles := req.Options["allFiles"] != "false"
+
+	d.debug("scan start", "site", req.SiteName, "directories", len(sources), "target_day", req.Day.Format("2006-01-02"))
+
+	results := make([]domain.Article, 0)
+	seen := map[string]struct{}{}
+	for _, src := range sources {
+		dir := strings.TrimSpace(src.URL)
+		if dir == "" {
+			dir = strings.TrimSpace(req.Options["path"])
+		}
+		if dir == "" {
+			return nil, fmt.Errorf("category %s: directory path is required", src.Name)
+		}
+
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, fmt.Errorf("category %s: read directory: %w", src.Name, err)
+		}
+		names := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+		sort.Strings(names)
+
+		source := articleSource(req.SiteName, src.Name)
+		for _, name := range names {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			path := filepath.Join(dir, name)
+			info, err := os.Stat(path)
+			if err != nil {
+				return nil, fmt.Errorf("category %s: stat %s: %w", src.Name, name, err)
+			}
+			if info.IsDir() || (!allFiles && !sameDay(info.ModTime(), req.Day)) {
+				continue
+			}
+
+			refs, ok, err := readReferenceFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("category %s: %w", src.Name, err)
+			}
+			if !ok {
+				continue
+			}
+
+			added := 0
+			for _, ref := range refs {
+				article, ok := ref.toArticle(info.ModTime().In(req.Day.Location()), source)
+				if !ok {
+					continue
+				}
+				if _, dup := seen[article.ID]; dup {
+					continue
+				}
+				seen[article.ID] = struct{}{}
+				results = append(results, article)
+				added++
+			}
+			d.debug("file processed", "category", src.Name, "file", name, "entries", len(refs), "added", added)
+		}
+	}
+
+	d.debug("scan finished", "site", req.SiteName, "total", len(results))
+	return results, nil
+}
+
+// readReferenceFile picks a parser by extension; unknown extensions report ok=false.
+func readReferenceFile(path string) ([]referenceEntry, bool, error) {
+	var parse func([]byte) ([]referenceEntry, error)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".bib", ".bibtex":
+		parse = func(data []byte) ([]referenceEntry, error) { return parseBibTeX(string(data)) }
+	case ".ris":
+		parse = func(data []byte) ([]referenceEntry, error) { return parseRIS(string(data)) }
+	case ".json":
+		parse = parseReferenceJSON
+	default:
+		return nil, false, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false, fmt.Errorf("read %s: %w", filepath.Base(path), err)
+	}
+	refs, err := parse(data)
+	if err != nil {
+		return nil, false, fmt.Errorf("parse %s: %w", filepath.Base(path), err)
+	}
+	return refs, true, nil
+}
+
+func (d *DirectoryScanner) debug(msg string, args ...interface{}) {
+	if d.logger != nil {
+		d.logger.Debug(msg, args...)
+	}
+}
@@ -0,0 +1,143 @@
+package parser
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"ArticlesScanner/internal/scanner"
+)
+
+const directoryBibTeX = `@string{nips = "NeurIPS"}
+@inproceedings{lee2025scaling,
+  title     = {Scaling {L}aws for \& Beyond},
+  author    = {Lee, Ann and Bo Chen},
+  booktitle = nips # " 2025",
+  year      = 2025,
+  month     = nov,
+  doi       = {10.5555/ABC.1},
+}
+@misc{chen2025,
+  title         = "A {Preprint}",
+  eprint        = {2511.00001},
+  archivePrefix = {arXiv},
+}
+`
+
+const directoryRIS = "TY  - JOUR\r\nTI  - Ice sheet dynamics\r\nAU  - Smith, Jane\r\nJO  - Nature\r\nPY  - 2025/11/07/\r\nID  - smith25\r\nER  - \r\n"
+
+const directoryJSON = `{"items":[{"title":"JSON paper","url":"https://example.org/p/1","authors":"Solo","publishedAt":"2025-11-08"},{"title":""}]}`
+
+func TestDirectoryScannerScan(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"refs.bib":   directoryBibTeX,
+		"list.ris":   directoryRIS,
+		"extra.json": directoryJSON,
+		"notes.txt":  "ignored",
+		"old.ris":    "TY  - JOUR\nTI  - Stale\nER  - \n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+
+	day := time.Date(2025, time.November, 8, 0, 0, 0, 0, time.UTC)
+	dropped := day.Add(9 * time.Hour)
+	for name := range files {
+		modTime := dropped
+		if name == "old.ris" {
+			modTime = day.AddDate(0, 0, -3)
+		}
+		if err := os.Chtimes(filepath.Join(dir, name), modTime, modTime); err != nil {
+			t.Fatalf("chtimes: %v", err)
+		}
+	}
+
+	sc := NewDirectoryScanner(nil)
+	req := scanner.Request{
+		Day:        day,
+		SiteName:   "manual",
+		Categories: []scanner.Category{{Name: "drop", URL: dir}},
+		Options:    map[string]string{"allFiles": "false"},
+	}
+
+	articles, err := sc.Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+	if len(articles) != 4 {
+		t.Fatalf("expected 4 articles, got %d: %+v", len(articles), articles)
+	}
+
+	byID := map[string]int{}
+	for i, article := range articles {
+		byID[article.ID] = i
+	}
+
+	bib, ok := byID["doi:10.5555/abc.1"]
+	if !ok {
+		t.Fatalf("missing bibtex doi entry: %+v", articles)
+	}
+	if got := articles[bib]; got.Title != "Scaling Laws for & Beyond" || got.Venue != "NeurIPS 2025" || len(got.Authors) != 2 || got.Authors[0] != "Ann Lee" {
+		t.Fatalf("unexpected bibtex article: %+v", got)
+	}
+	if got := articles[bib].PublishedAt; got.Year() != 2025 || got.Month() != time.November {
+		t.Fatalf("unexpected bibtex date: %v", got)
+	}
+	if i, ok := byID["arXiv:2511.00001"]; !ok || articles[i].URL != "https://arxiv.org/abs/2511.00001" {
+		t.Fatalf("missing arXiv entry: %+v", articles)
+	}
+	if i, ok := byID["ris:smith25"]; !ok || articles[i].Venue != "Nature" || articles[i].Authors[0] != "Jane Smith" || articles[i].PublishedAt.Day() != 7 {
+		t.Fatalf("unexpected ris entry: %+v", articles)
+	}
+	for _, article := range articles {
+		if article.Title == "JSON paper" && (len(article.Authors) != 1 || article.Source != "manual/drop" || !article.PublishedAt.Equal(day)) {
+			t.Fatalf("unexpected json entry: %+v", article)
+		}
+	}
+
+	// By default files dropped on other days, e.g. after the previous run, are read as well.
+	req.Options = nil
+	articles, err = sc.Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+	if len(articles) != 5 {
+		t.Fatalf("expected the stale file by default, got %d", len(articles))
+	}
+}
+
+func TestParseBibTeXParenthesesInValues(t *testing.T) {
+	t.Parallel()
+
+	data := `@article{part1,
+  title = {Results (part 1},
+  note = "see :)",
+  year = 2025
+}
+@inproceedings(paren,
+  title = {Closing ) inside braces},
+  booktitle = "Workshop (extended",
+  year = 2024
+)`
+
+	entries, err := parseBibTeX(data)
+	if err != nil {
+		t.Fatalf("parseBibTeX error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected two entries, got %+v", entries)
+	}
+	if entries[0].Key != "part1" || entries[0].Title != "Results (part 1" {
+		t.Fatalf("unexpected first entry: %+v", entries[0])
+	}
+	if entries[1].Key != "paren" || entries[1].Title != "Closing ) inside braces" || entries[1].Venue != "Workshop (extended" {
+		t.Fatalf("unexpected parenthesized entry: %+v", entries[1])
+	}
+}
@@ -0,0 +1,97 @@
+package parser
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// fetchBody performs a GET request and returns the full response body on HTTP 200.
+func fetchBody(ctx context.Context, client *http.Client, target string, header http.Header) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request %s: %w", target, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		payload, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		closeErr := resp.Body.Close()
+		if closeErr != nil {
+			return nil, fmt.Errorf("unexpected status %s: %s, close body: %v", resp.Status, strings.TrimSpace(string(payload)), closeErr)
+		}
+		return nil, fmt.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(payload)))
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("read body: %w", err)
+	}
+
+	if err := resp.Body.Close(); err != nil {
+		return nil, fmt.Errorf("close response body: %w", err)
+	}
+
+	return body, nil
+}
+
+// articleSource mirrors the "site/category" convention used by all strategies.
+func articleSource(siteName, category string) string {
+	if category == "" {
+		return siteName
+	}
+	return fmt.Sprintf("%s/%s", siteName, category)
+}
+
+// optionOr returns the trimmed option value or the fallback when absent.
+func optionOr(options map[string]string, key, fallback string) string {
+	if v := strings.TrimSpace(options[key]); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func withQuery(base string, query url.Values) (string, error) {
+	parsed, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("invalid endpoint %s: %w", base, err)
+	}
+	merged := parsed.Query()
+	for key, values := range query {
+		merged[key] = values
+	}
+	parsed.RawQuery = merged.Encode()
+	return parsed.String(), nil
+}
+
+// collapseSpaces folds the hard line breaks arXiv keeps in metadata fields.
+func collapseSpaces(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// startOfDay truncates to midnight in the day's own location (the scheduler timezone).
+func startOfDay(day time.Time) time.Time {
+	y, m, d := day.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, day.Location())
+}
+
+// sameDay reports whether t falls on the calendar day of day, evaluated in day's location.
+func sameDay(t, day time.Time) bool {
+	y1, m1, d1 := t.In(day.Location()).Date()
+	y2, m2, d2 := day.Date()
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
@@ -0,0 +1,65 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+var doiExpr = regexp.MustCompile(`10\.\d{4,9}/[^\s"'<>?#]+`)
+
+// feedDateLayouts covers RFC 822 variants seen in RSS plus ISO 8601 used by Atom and Dublin Core.
+var feedDateLayouts = []string{
+	time.RFC3339,
+	time.RFC1123Z,
+	time.RFC1123,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"2 Jan 2006 15:04:05 -0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02",
+}
+
+// findDOI extracts the first DOI-looking token from identifiers, links or free text.
+func findDOI(values ...string) string {
+	for _, value := range values {
+		if match := doiExpr.FindString(value); match != "" {
+			return strings.TrimRight(match, ".,;)")
+		}
+	}
+	return ""
+}
+
+// doiArticleID builds the storage identity for DOI-addressed works so dedup works across sources.
+func doiArticleID(doi string) string {
+	return "doi:" + strings.ToLower(strings.TrimSpace(doi))
+}
+
+// parseFeedDate tries all known layouts; zone-less values are read in loc.
+func parseFeedDate(value string, loc *time.Location) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range feedDateLayouts {
+		if parsed, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// htmlText drops markup from feed descriptions and abstracts, keeping readable text only.
+func htmlText(value string) string {
+	if !strings.Contains(value, "<") {
+		return collapseSpaces(value)
+	}
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(value))
+	if err != nil {
+		return collapseSpaces(value)
+	}
+	return collapseSpaces(doc.Text())
+}
@@ -0,0 +1,307 @@
+package parser
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/scanner"
+)
+
+const (
+	jsonAPIDefaultMaxPages = 10
+	jsonAPIHeaderPrefix    = "header."
+)
+
+// JSONAPIScanner reads articles from JSON HTTP APIs whose shape is described with path expressions.
+type JSONAPIScanner struct {
+	client     *http.Client
+	defaultURL string
+	logger     *slog.Logger
+}
+
+// NewJSONAPIScanner wires an HTTP client; defaultURL (providers.articleApiUrl) is used when a site sets no URL.
+func NewJSONAPIScanner(client *http.Client, defaultURL string, log *slog.Logger) *JSONAPIScanner {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &JSONAPIScanner{client: client, defaultURL: defaultURL, logger: log}
+}
+
+// Name identifies the strategy inside the registry.
+func (j *JSONAPIScanner) Name() string {
+	return "jsonapi"
+}
+
+// jsonAPIRules is the parsed form of the site options driving the scanner.
+type jsonAPIRules struct {
+	itemsPath      string
+	idPath         string
+	titlePath      string
+	abstractPath   string
+	urlPath        string
+	datePath       string
+	dateLayout     string
+	authorsPath    string
+	doiPath        string
+	pagination     string
+	pageParam      string
+	startPage      int
+	pageSizeParam  string
+	pageSize       int
+	cursorParam    string
+	nextCursorPath string
+	maxPages       int
+	dayParam       string
+	dayLayout      string
+	header         http.Header
+}
+
+// parseJSONAPIRules reads path expressions (itemsPath, idPath, titlePath, abstractPath, urlPath, datePath,
+// authorsPath, doiPath), pagination ("page" or "cursor"), the optional dayParam, and auth: bearerTokenEnv names
+// an environment variable with the token, "header.<Name>" options add headers with ${ENV} expansion.
+func parseJSONAPIRules(options map[string]string) (jsonAPIRules, error) {
+	rules := jsonAPIRules{
+		itemsPath:      optionOr(options, "itemsPath", "$"),
+		idPath:         optionOr(options, "idPath", "id"),
+		titlePath:      optionOr(options, "titlePath", "title"),
+		abstractPath:   optionOr(options, "abstractPath", "abstract"),
+		urlPath:        optionOr(options, "urlPath", "url"),
+		datePath:       strings.TrimSpace(options["datePath"]),
+		dateLayout:     strings.TrimSpace(options["dateLayout"]),
+		authorsPath:    strings.TrimSpace(options["authorsPath"]),
+		doiPath:        strings.TrimSpace(options["doiPath"]),
+		pagination:     optionOr(options, "pagination", "none"),
+		pageParam:      optionOr(options, "pageParam", "page"),
+		startPage:      1,
+		pageSizeParam:  strings.TrimSpace(options["pageSizeParam"]),
+		cursorParam:    optionOr(options, "cursorParam", "cursor"),
+		nextCursorPath: optionOr(options, "nextCursorPath", "next_cursor"),
+		maxPages:       jsonAPIDefaultMaxPages,
+		dayParam:       strings.TrimSpace(options["dayParam"]),
+		dayLayout:      optionOr(options, "dayLayout", "2006-01-02"),
+		header:         http.Header{"Accept": {"application/json"}},
+	}
+
+	for key, target := range map[string]*int{"startPage": &rules.startPage, "pageSize": &rules.pageSize, "maxPages": &rules.maxPages} {
+		if raw := strings.TrimSpace(options[key]); raw != "" {
+			v, err := strconv.Atoi(raw)
+			if err != nil || v < 0 || (v == 0 && key != "startPage") {
+				return jsonAPIRules{}, fmt.Errorf("invalid %s %q", key, raw)
+			}
+			*target = v
+		}
+	}
+
+	switch rules.pagination {
+	case "none", "page", "cursor":
+	default:
+		return jsonAPIRules{}, fmt.Errorf("unsupported pagination %s", rules.pagination)
+	}
+
+	if env := strings.TrimSpace(options["bearerTokenEnv"]); env != "" {
+		token := os.Getenv(env)
+		if token == "" {
+			return jsonAPIRules{}, fmt.Errorf("bearer token env %s is empty", env)
+		}
+		rules.header.Set("Authorization", "Bearer "+token)
+	}
+	for key, value := range options {
+		if name, ok := strings.CutPrefix(key, jsonAPIHeaderPrefix); ok && name != "" {
+			rules.header.Set(name, os.ExpandEnv(value))
+		}
+	}
+
+	return rules, nil
+}
+
+// Scan requests each endpoint (category URL, the "url" option or providers.articleApiUrl), follows
+// pagination and returns items dated on the requested day; items are attributed to it when no datePath is set.
+func (j *JSONAPIScanner) Scan(ctx context.Context, req scanner.Request) ([]domain.Article, error) {
+	rules, err := parseJSONAPIRules(req.Options)
+	if err != nil {
+		return nil, fmt.Errorf("site %s: %w", req.SiteName, err)
+	}
+
+	endpoints := req.Categories
+	if len(endpoints) == 0 {
+		endpoints = []scanner.Category{{}}
+	}
+
+	j.debug("scan start", "site", req.SiteName, "endpoints", len(endpoints), "target_day", req.Day.Format("2006-01-02"))
+
+	results := make([]domain.Article, 0)
+	seen := map[string]struct{}{}
+	for _, cat := range endpoints {
+		endpoint := strings.TrimSpace(cat.URL)
+		if endpoint == "" {
+			endpoint = optionOr(req.Options, "url", j.defaultURL)
+		}
+		if endpoint == "" {
+			return nil, fmt.Errorf("no endpoint configured for site %s", req.SiteName)
+		}
+
+		articles, err := j.scanEndpoint(ctx, rules, endpoint, req.Day, articleSource(req.SiteName, cat.Name))
+		if err != nil {
+			return nil, fmt.Errorf("endpoint %s: %w", endpoint, err)
+		}
+		for _, article := range articles {
+			if _, ok := seen[article.ID]; ok {
+				continue
+			}
+			seen[article.ID] = struct{}{}
+			results = append(results, article)
+		}
+	}
+
+	j.debug("scan finished", "site", req.SiteName, "total", len(results))
+	return results, nil
+}
+
+func (j *JSONAPIScanner) scanEndpoint(ctx context.Context, rules jsonAPIRules, endpoint string, day time.Time, source string) ([]domain.Article, error) {
+	var (
+		collected []domain.Article
+		page      = rules.startPage
+		cursor    string
+	)
+
+	for i := 0; i < rules.maxPages; i++ {
+		query := url.Values{}
+		if rules.dayParam != "" {
+			query.Set(rules.dayParam, day.Format(rules.dayLayout))
+		}
+		if rules.pageSizeParam != "" && rules.pageSize > 0 {
+			query.Set(rules.pageSizeParam, strconv.Itoa(rules.pageSize))
+		}
+		switch rules.pagination {
+		case "page":
+			query.Set(rules.pageParam, strconv.Itoa(page))
+		case "cursor":
+			if cursor != "" {
+				query.Set(rules.cursorParam, cursor)
+			}
+		}
+
+		pageURL, err := withQuery(endpoint, query)
+		if err != nil {
+			return nil, err
+		}
+		j.debug("requesting", "url", pageURL)
+
+		body, err := fetchBody(ctx, j.client, pageURL, rules.header)
+		if err != nil {
+			return nil, err
+		}
+
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+		var doc any
+		if err := decoder.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode response: %w", err)
+		}
+
+		items, err := jsonPath(doc, rules.itemsPath)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) == 1 {
+			if arr, ok := items[0].([]any); ok {
+				items = arr
+			}
+		}
+
+		for _, item := range items {
+			if article, ok := rules.toArticle(item, day, source); ok {
+				collected = append(collected, article)
+			}
+		}
+		j.debug("page processed", "url", pageURL, "items", len(items), "collected", len(collected))
+
+		switch rules.pagination {
+		case "page":
+			if len(items) == 0 || (rules.pageSize > 0 && len(items) < rules.pageSize) {
+				return collected, nil
+			}
+			page++
+		case "cursor":
+			next := jsonPathString(doc, rules.nextCursorPath)
+			if next == "" || next == cursor || len(items) == 0 {
+				return collected, nil
+			}
+			cursor = next
+		default:
+			return collected, nil
+		}
+	}
+
+	return collected, nil
+}
+
+func (r jsonAPIRules) toArticle(item any, day time.Time, source string) (domain.Article, bool) {
+	title := collapseSpaces(jsonPathString(item, r.titlePath))
+	link := jsonPathString(item, r.urlPath)
+	doi := jsonPathString(item, r.doiPath)
+
+	id := jsonPathString(item, r.idPath)
+	if id == "" && doi != "" {
+		id = doiArticleID(doi)
+	}
+	if id == "" {
+		id = link
+	}
+	if id == "" || title == "" {
+		return domain.Article{}, false
+	}
+
+	publishedAt := startOfDay(day)
+	if r.datePath != "" {
+		parsed, ok := r.parseDate(jsonPathString(item, r.datePath), day.Location())
+		if !ok || !sameDay(parsed, day) {
+			return domain.Article{}, false
+		}
+		publishedAt = parsed
+	}
+
+	return domain.Article{
+		ID:          id,
+		Title:       title,
+		Abstract:    htmlText(jsonPathString(item, r.abstractPath)),
+		URL:         link,
+		Source:      source,
+		Authors:     jsonPathStrings(item, r.authorsPath),
+		DOI:         doi,
+		PublishedAt: publishedAt,
+	}, true
+}
+
+// parseDate honours dateLayout ("unix" for epoch seconds) and otherwise tries the common feed layouts.
+func (r jsonAPIRules) parseDate(value string, loc *time.Location) (time.Time, bool) {
+	switch r.dateLayout {
+	case "":
+		return parseFeedDate(value, loc)
+	case "unix":
+		secs, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return time.Unix(int64(secs), 0), true
+	default:
+		parsed, err := time.ParseInLocation(r.dateLayout, value, loc)
+		return parsed, err == nil
+	}
+}
+
+func (j *JSONAPIScanner) debug(msg string, args ...interface{}) {
+	if j.logger != nil {
+		j.logger.Debug(msg, args...)
+	}
+}
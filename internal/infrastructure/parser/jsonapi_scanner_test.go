@@ -0,0 +1,146 @@
+package parser
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ArticlesScanner/internal/scanner"
+)
+
+func TestJSONPath(t *testing.T) {
+	t.Parallel()
+
+	var doc any
+	if err := json.Unmarshal([]byte(`{"data":{"items":[{"id":7,"authors":[{"name":"A"},{"name":"B"}]},{"id":"x"}]}}`), &doc); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	if got := jsonPathString(doc, "$.data.items[0].id"); got != "7" {
+		t.Fatalf("unexpected id: %q", got)
+	}
+	if got := jsonPathString(doc, "data.items[-1].id"); got != "x" {
+		t.Fatalf("unexpected negative index: %q", got)
+	}
+	if got := strings.Join(jsonPathStrings(doc, "data.items[*].authors[*].name"), ","); got != "A,B" {
+		t.Fatalf("unexpected wildcard result: %q", got)
+	}
+	if got := jsonPathString(doc, "data.missing"); got != "" {
+		t.Fatalf("expected empty for missing key, got %q", got)
+	}
+	if _, err := jsonPath(doc, "data.items[0"); err == nil {
+		t.Fatal("expected error for unterminated index")
+	}
+}
+
+func TestJSONAPIScannerCursorPagination(t *testing.T) {
+	t.Setenv("VENDOR_TOKEN", "s3cret")
+	t.Setenv("VENDOR_TENANT", "lab")
+
+	var cursors []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer s3cret" || r.Header.Get("X-Tenant") != "lab" {
+			t.Errorf("missing auth headers: %v", r.Header)
+		}
+		if r.URL.Query().Get("date") != "2025-11-08" {
+			t.Errorf("missing day parameter: %s", r.URL.RawQuery)
+		}
+		cursor := r.URL.Query().Get("after")
+		cursors = append(cursors, cursor)
+
+		switch cursor {
+		case "":
+			_, _ = w.Write([]byte(`{"result":{"papers":[
+			  {"paper_id":101,"name":"First","summary":"<p>One</p>","link":"https://feed/101","published":"2025-11-08T10:00:00Z","people":[{"full":"Ann"}]},
+			  {"paper_id":100,"name":"Old","published":"2025-11-07T10:00:00Z"}
+			]},"meta":{"next":"c2"}}`))
+		case "c2":
+			_, _ = w.Write([]byte(`{"result":{"papers":[
+			  {"name":"DOI only","doi":"10.5555/XYZ","published":"2025-11-08T12:00:00Z"}
+			]},"meta":{"next":""}}`))
+		default:
+			t.Errorf("unexpected cursor %s", cursor)
+		}
+	}))
+	defer server.Close()
+
+	sc := NewJSONAPIScanner(server.Client(), server.URL+"/articles", nil)
+	req := scanner.Request{
+		Day:      time.Date(2025, time.November, 8, 6, 0, 0, 0, time.UTC),
+		SiteName: "vendor",
+		Options: map[string]string{
+			"itemsPath":       "result.papers",
+			"idPath":          "paper_id",
+			"titlePath":       "name",
+			"abstractPath":    "summary",
+			"urlPath":         "link",
+			"datePath":        "published",
+			"authorsPath":     "people[*].full",
+			"doiPath":         "doi",
+			"pagination":      "cursor",
+			"cursorParam":     "after",
+			"nextCursorPath":  "meta.next",
+			"dayParam":        "date",
+			"bearerTokenEnv":  "VENDOR_TOKEN",
+			"header.X-Tenant": "${VENDOR_TENANT}",
+		},
+	}
+
+	articles, err := sc.Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+
+	if len(cursors) != 2 {
+		t.Fatalf("expected 2 requests, got %v", cursors)
+	}
+	if len(articles) != 2 {
+		t.Fatalf("expected 2 articles, got %d: %+v", len(articles), articles)
+	}
+	if articles[0].ID != "101" || articles[0].Abstract != "One" || articles[0].Source != "vendor" {
+		t.Fatalf("unexpected first article: %+v", articles[0])
+	}
+	if len(articles[0].Authors) != 1 || articles[0].Authors[0] != "Ann" {
+		t.Fatalf("unexpected authors: %v", articles[0].Authors)
+	}
+	if articles[1].ID != "doi:10.5555/xyz" {
+		t.Fatalf("expected doi-based id, got %s", articles[1].ID)
+	}
+}
+
+func TestJSONAPIScannerPagePagination(t *testing.T) {
+	t.Parallel()
+
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages = append(pages, r.URL.Query().Get("p"))
+		if r.URL.Query().Get("p") == "1" {
+			_, _ = w.Write([]byte(`[{"id":"a","title":"A"},{"id":"b","title":"B"}]`))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"id":"c","title":"C"}]`))
+	}))
+	defer server.Close()
+
+	req := scanner.Request{
+		Day:        time.Date(2025, time.November, 8, 0, 0, 0, 0, time.UTC),
+		SiteName:   "internal",
+		Options:    map[string]string{"pagination": "page", "pageParam": "p", "pageSizeParam": "size", "pageSize": "2"},
+		Categories: []scanner.Category{{Name: "ml", URL: server.URL + "/feed"}},
+	}
+
+	articles, err := NewJSONAPIScanner(server.Client(), "", nil).Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+	if len(pages) != 2 || len(articles) != 3 {
+		t.Fatalf("unexpected pages %v or articles %d", pages, len(articles))
+	}
+	if articles[2].Source != "internal/ml" {
+		t.Fatalf("unexpected source: %s", articles[2].Source)
+	}
+}
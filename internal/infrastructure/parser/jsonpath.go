@@ -0,0 +1,141 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonPath evaluates a small JSONPath subset over decoded JSON: dotted keys, [N] indexes and [*]
+// wildcards, with an optional leading "$". It returns every matched value in document order.
+func jsonPath(doc any, path string) ([]any, error) {
+	path = strings.TrimPrefix(strings.TrimSpace(path), "$")
+	path = strings.TrimPrefix(path, ".")
+	current := []any{doc}
+	if path == "" {
+		return current, nil
+	}
+
+	for _, segment := range strings.Split(path, ".") {
+		key, indexes, err := splitPathSegment(segment)
+		if err != nil {
+			return nil, fmt.Errorf("path %s: %w", path, err)
+		}
+
+		var next []any
+		for _, node := range current {
+			if key != "" {
+				obj, ok := node.(map[string]any)
+				if !ok {
+					continue
+				}
+				value, ok := obj[key]
+				if !ok {
+					continue
+				}
+				node = value
+			}
+			next = append(next, applyIndexes(node, indexes)...)
+		}
+		current = next
+	}
+	return current, nil
+}
+
+// splitPathSegment separates "items[0][*]" into the key and its bracketed selectors.
+func splitPathSegment(segment string) (string, []string, error) {
+	key, rest, hasIndex := strings.Cut(segment, "[")
+	if !hasIndex {
+		return key, nil, nil
+	}
+
+	var indexes []string
+	rest = "[" + rest
+	for rest != "" {
+		if !strings.HasPrefix(rest, "[") {
+			return "", nil, fmt.Errorf("malformed segment %s", segment)
+		}
+		end := strings.Index(rest, "]")
+		if end < 0 {
+			return "", nil, fmt.Errorf("unterminated index in %s", segment)
+		}
+		indexes = append(indexes, rest[1:end])
+		rest = rest[end+1:]
+	}
+	return key, indexes, nil
+}
+
+func applyIndexes(node any, indexes []string) []any {
+	nodes := []any{node}
+	for _, index := range indexes {
+		var next []any
+		for _, n := range nodes {
+			arr, ok := n.([]any)
+			if !ok {
+				continue
+			}
+			if index == "*" {
+				next = append(next, arr...)
+				continue
+			}
+			i, err := strconv.Atoi(index)
+			if err != nil {
+				continue
+			}
+			if i < 0 {
+				i += len(arr)
+			}
+			if i >= 0 && i < len(arr) {
+				next = append(next, arr[i])
+			}
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+// jsonPathString returns the first match rendered as a string, or "" when nothing matched.
+func jsonPathString(doc any, path string) string {
+	if strings.TrimSpace(path) == "" {
+		return ""
+	}
+	values, err := jsonPath(doc, path)
+	if err != nil || len(values) == 0 {
+		return ""
+	}
+	return jsonScalar(values[0])
+}
+
+// jsonPathStrings renders every match as a string, skipping empty and non-scalar values.
+func jsonPathStrings(doc any, path string) []string {
+	if strings.TrimSpace(path) == "" {
+		return nil
+	}
+	values, err := jsonPath(doc, path)
+	if err != nil {
+		return nil
+	}
+	var out []string
+	for _, value := range values {
+		if s := jsonScalar(value); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func jsonScalar(value any) string {
+	switch v := value.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case json.Number:
+		return v.String()
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return ""
+	}
+}
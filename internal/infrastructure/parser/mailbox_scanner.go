@@ -0,0 +1,492 @@
+package parser
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/text/encoding/htmlindex"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/scanner"
+)
+
+// minAlertTitle skips anchors such as "PDF" or "Full text" that point at papers but carry no title.
+const minAlertTitle = 15
+
+var (
+	mailURLExpr       = regexp.MustCompile(`https?://[^\s<>"')\]]+`)
+	arxivAbsExpr      = regexp.MustCompile(`arxiv\.org/(?:abs|pdf)/([^\s?#]+?)(?:v\d+)?(?:\.pdf)?$`)
+	researchGateExpr  = regexp.MustCompile(`researchgate\.net/publication/(\d+)`)
+	paperLinkPatterns = []string{"doi.org/", "/doi/", "arxiv.org/abs/", "researchgate.net/publication/", "/article/", "/articles/", "/science/article/", "/paper/"}
+)
+
+// MailboxScanner turns e-mail alerts (Google Scholar, journal TOCs, ResearchGate) stored locally into articles.
+type MailboxScanner struct {
+	logger *slog.Logger
+}
+
+// NewMailboxScanner builds a scanner that reads mbox files and Maildir folders from disk.
+func NewMailboxScanner(log *slog.Logger) *MailboxScanner {
+	return &MailboxScanner{logger: log}
+}
+
+// Name identifies the strategy inside the registry.
+func (m *MailboxScanner) Name() string {
+	return "mailbox"
+}
+
+// Scan reads every message in the mailboxes and extracts paper links from the alert bodies; storage
+// dedup skips papers already delivered, so alerts received after a day's run reach the next one.
+//
+// Each category URL is an mbox file or Maildir directory; without categories option "path" is used.
+// Option "allMessages" = "false" reads only messages dated on the requested day.
+func (m *MailboxScanner) Scan(ctx context.Context, req scanner.Request) ([]domain.Article, error) {
+	sources := req.Categories
+	if len(sources) == 0 {
+		path := strings.TrimSpace(req.Options["path"])
+		if path == "" {
+			return nil, fmt.Errorf("no mailbox path provided for site %s", req.SiteName)
+		}
+		sources = []scanner.Category{{Name: "inbox", URL: path}}
+	}
+
+	allMessages := req.Options["allMessages"] != "false"
+
+	m.debug("scan start", "site", req.SiteName, "mailboxes", len(sources), "target_day", req.Day.Format("2006-01-02"))
+
+	results := make([]domain.Article, 0)
+	seen := map[string]struct{}{}
+	for _, src := range sources {
+		path := strings.TrimSpace(src.URL)
+		if path == "" {
+			path = strings.TrimSpace(req.Options["path"])
+		}
+		if path == "" {
+			return nil, fmt.Errorf("category %s: mailbox path is required", src.Name)
+		}
+
+		raws, err := readMailbox(path)
+		if err != nil {
+			return nil, fmt.Errorf("category %s: %w", src.Name, err)
+		}
+
+		source := articleSource(req.SiteName, src.Name)
+		matched := 0
+		for _, raw := range raws {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			msg, err := parseMailMessage(raw)
+			if err != nil {
+				m.debug("skipping unreadable message", "mailbox", path, "error", err)
+				continue
+			}
+			if !allMessages && !sameDay(msg.Date, req.Day) {
+				continue
+			}
+			for _, warning := range msg.Warnings {
+				m.warn("message partly undecoded", "mailbox", path, "subject", msg.Subject, "warning", warning)
+			}
+			matched++
+			for _, link := range alertLinks(msg) {
+				article := link.toArticle(msg.Date, source)
+				if _, ok := seen[article.ID]; ok {
+					continue
+				}
+				seen[article.ID] = struct{}{}
+				results = append(results, article)
+			}
+		}
+		m.debug("mailbox processed", "category", src.Name, "messages", len(raws), "matched", matched, "collected", len(results))
+	}
+
+	m.debug("scan finished", "site", req.SiteName, "total", len(results))
+	return results, nil
+}
+
+// readMailbox returns raw messages from a Maildir (cur/ and new/) or an mbox file.
+func readMailbox(path string) ([][]byte, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("stat mailbox: %w", err)
+	}
+	if !info.IsDir() {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read mbox: %w", err)
+		}
+		return splitMbox(data), nil
+	}
+
+	var files []string
+	for _, sub := range []string{"new", "cur"} {
+		entries, err := os.ReadDir(filepath.Join(path, sub))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("read maildir: %w", err)
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
+				files = append(files, filepath.Join(path, sub, entry.Name()))
+			}
+		}
+	}
+	sort.Strings(files)
+
+	raws := make([][]byte, 0, len(files))
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read message %s: %w", file, err)
+		}
+		raws = append(raws, data)
+	}
+	return raws, nil
+}
+
+// splitMbox cuts an mbox file on "From " separator lines and undoes mboxrd ">From " quoting.
+func splitMbox(data []byte) [][]byte {
+	var (
+		messages [][]byte
+		current  bytes.Buffer
+		started  bool
+		prevLine = true
+	)
+	flush := func() {
+		if started && current.Len() > 0 {
+			messages = append(messages, append([]byte(nil), current.Bytes()...))
+		}
+		current.Reset()
+	}
+
+	scan := bufio.NewScanner(bytes.NewReader(data))
+	scan.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scan.Scan() {
+		line := strings.TrimSuffix(scan.Text(), "\r")
+		if strings.HasPrefix(line, "From ") && prevLine {
+			flush()
+			started = true
+			prevLine = false
+			continue
+		}
+		if unquoted := strings.TrimLeft(line, ">"); len(unquoted) < len(line) && strings.HasPrefix(unquoted, "From ") {
+			line = line[1:]
+		}
+		current.WriteString(line)
+		current.WriteString("\r\n")
+		prevLine = line == ""
+	}
+	flush()
+	return messages
+}
+
+type mailMessage struct {
+	From    string
+	Subject string
+	Date    time.Time
+	HTML    string
+	Text    string
+	// Warnings lists parts kept undecoded, e.g. in an unknown charset.
+	Warnings []string
+}
+
+var mailWordDecoder = &mime.WordDecoder{CharsetReader: charsetReader}
+
+func parseMailMessage(raw []byte) (mailMessage, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return mailMessage{}, fmt.Errorf("read message: %w", err)
+	}
+
+	date, err := msg.Header.Date()
+	if err != nil {
+		return mailMessage{}, fmt.Errorf("message date: %w", err)
+	}
+	subject, err := mailWordDecoder.DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		subject = msg.Header.Get("Subject")
+	}
+	from, err := mailWordDecoder.DecodeHeader(msg.Header.Get("From"))
+	if err != nil {
+		from = msg.Header.Get("From")
+	}
+
+	result := mailMessage{From: from, Subject: collapseSpaces(subject), Date: date}
+	if err := collectMIMEBodies(msg.Header.Get("Content-Type"), msg.Header.Get("Content-Transfer-Encoding"), msg.Body, &result); err != nil {
+		return mailMessage{}, err
+	}
+	return result, nil
+}
+
+// collectMIMEBodies walks multipart trees and keeps the first HTML and plain-text bodies, skipping attachments.
+func collectMIMEBodies(contentType, encoding string, body io.Reader, msg *mailMessage) error {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType, params = "text/plain", map[string]string{}
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("read multipart: %w", err)
+			}
+			if disposition, _, _ := mime.ParseMediaType(part.Header.Get("Content-Disposition")); disposition == "attachment" {
+				continue
+			}
+			if err := collectMIMEBodies(part.Header.Get("Content-Type"), part.Header.Get("Content-Transfer-Encoding"), part, msg); err != nil {
+				return err
+			}
+		}
+	}
+
+	if mediaType != "text/html" && mediaType != "text/plain" {
+		return nil
+	}
+
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "quoted-printable":
+		body = quotedprintable.NewReader(body)
+	case "base64":
+		body = base64.NewDecoder(base64.StdEncoding, body)
+	}
+	decoded, err := charsetReader(params["charset"], body)
+	if err != nil {
+		// One odd part must not discard the alert: its raw bytes still carry the links.
+		msg.Warnings = append(msg.Warnings, fmt.Sprintf("%s part kept undecoded: %v", mediaType, err))
+		decoded = body
+	}
+	data, err := io.ReadAll(decoded)
+	if err != nil {
+		return fmt.Errorf("read %s body: %w", mediaType, err)
+	}
+
+	if mediaType == "text/html" && msg.HTML == "" {
+		msg.HTML = string(data)
+	}
+	if mediaType == "text/plain" && msg.Text == "" {
+		msg.Text = string(data)
+	}
+	return nil
+}
+
+// charsetReader decodes any charset label known to the WHATWG encoding index (windows-125x,
+// iso-8859-x, koi8-r, utf-16, ...) to UTF-8.
+func charsetReader(charset string, input io.Reader) (io.Reader, error) {
+	label := strings.ToLower(strings.TrimSpace(charset))
+	switch label {
+	case "", "utf-8", "utf8", "us-ascii", "ascii":
+		return input, nil
+	}
+	enc, err := htmlindex.Get(label)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported charset %s", charset)
+	}
+	return enc.NewDecoder().Reader(input), nil
+}
+
+type alertLink struct {
+	Title   string
+	URL     string
+	Snippet string
+	Authors []string
+}
+
+// alertLinks recognises Google Scholar alert markup and falls back to paper-looking anchors or bare URLs.
+func alertLinks(msg mailMessage) []alertLink {
+	if strings.TrimSpace(msg.HTML) == "" {
+		return textAlertLinks(msg.Text)
+	}
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(msg.HTML))
+	if err != nil {
+		return textAlertLinks(msg.Text)
+	}
+
+	var links []alertLink
+	seen := map[string]bool{}
+	add := func(link alertLink) {
+		if link.URL == "" || seen[link.URL] {
+			return
+		}
+		seen[link.URL] = true
+		links = append(links, link)
+	}
+
+	doc.Find("a.gse_alrt_title").Each(func(_ int, a *goquery.Selection) {
+		href, _ := a.Attr("href")
+		heading := a.Closest("h3")
+		if heading.Length() == 0 {
+			heading = a
+		}
+		link := alertLink{
+			Title:   collapseSpaces(a.Text()),
+			URL:     unwrapRedirect(href),
+			Snippet: collapseSpaces(heading.NextAllFiltered(".gse_alrt_sni").First().Text()),
+		}
+		// The byline reads "A Lee, B Chen - Journal, 2025"; keep the author part.
+		byline := collapseSpaces(heading.NextAllFiltered("div").Not(".gse_alrt_sni").First().Text())
+		if authors, _, ok := strings.Cut(byline, " - "); ok {
+			for _, author := range strings.Split(authors, ",") {
+				if author = strings.Trim(strings.TrimSpace(author), "…"); author != "" {
+					link.Authors = append(link.Authors, author)
+				}
+			}
+		}
+		add(link)
+	})
+	if len(links) > 0 {
+		return links
+	}
+
+	doc.Find("a[href]").Each(func(_ int, a *goquery.Selection) {
+		href, _ := a.Attr("href")
+		target := unwrapRedirect(href)
+		title := collapseSpaces(a.Text())
+		if !isPaperLink(target) || utf8.RuneCountInString(title) < minAlertTitle {
+			return
+		}
+		add(alertLink{Title: title, URL: target})
+	})
+	return links
+}
+
+// textAlertLinks pairs paper URLs in plain-text alerts with the nearest preceding non-URL line as title.
+func textAlertLinks(text string) []alertLink {
+	var (
+		links []alertLink
+		title string
+	)
+	seen := map[string]bool{}
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		match := mailURLExpr.FindString(line)
+		if match == "" {
+			if utf8.RuneCountInString(line) >= minAlertTitle {
+				title = line
+			}
+			continue
+		}
+		target := unwrapRedirect(match)
+		if !isPaperLink(target) || seen[target] {
+			continue
+		}
+		if rest := collapseSpaces(mailURLExpr.ReplaceAllString(line, "")); utf8.RuneCountInString(rest) >= minAlertTitle {
+			title = rest
+		}
+		if title == "" {
+			continue
+		}
+		seen[target] = true
+		links = append(links, alertLink{Title: title, URL: target})
+		title = ""
+	}
+	return links
+}
+
+// unwrapRedirect follows tracking wrappers (scholar_url?url=, click?u=) to the target and drops RG tracking.
+func unwrapRedirect(href string) string {
+	href = strings.TrimSpace(href)
+	for range 3 {
+		parsed, err := url.Parse(href)
+		if err != nil || parsed.Host == "" {
+			return href
+		}
+		next := ""
+		for _, key := range []string{"url", "u", "q", "target"} {
+			if value := parsed.Query().Get(key); strings.HasPrefix(value, "http") {
+				next = value
+				break
+			}
+		}
+		if next == "" {
+			if strings.Contains(parsed.Host, "researchgate.net") {
+				parsed.RawQuery, parsed.Fragment = "", ""
+				return parsed.String()
+			}
+			return href
+		}
+		href = next
+	}
+	return href
+}
+
+func isPaperLink(target string) bool {
+	lower := strings.ToLower(target)
+	if !strings.HasPrefix(lower, "http") {
+		return false
+	}
+	for _, pattern := range paperLinkPatterns {
+		if strings.Contains(lower, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// toArticle keys links by DOI, arXiv ID or ResearchGate publication, falling back to a hash of the URL.
+func (l alertLink) toArticle(received time.Time, source string) domain.Article {
+	article := domain.Article{
+		Title:       l.Title,
+		Abstract:    l.Snippet,
+		URL:         l.URL,
+		Source:      source,
+		Authors:     l.Authors,
+		PublishedAt: received,
+	}
+
+	switch doi := findDOI(l.URL); {
+	case doi != "":
+		article.ID = doiArticleID(doi)
+		article.DOI = doi
+	case arxivAbsExpr.MatchString(l.URL):
+		article.ID = "arXiv:" + arxivAbsExpr.FindStringSubmatch(l.URL)[1]
+	case researchGateExpr.MatchString(l.URL):
+		article.ID = "researchgate:" + researchGateExpr.FindStringSubmatch(l.URL)[1]
+	default:
+		article.ID = "link:" + linkHash(l.URL)
+	}
+	return article
+}
+
+func linkHash(value string) string {
+	sum := sha1.Sum([]byte(value))
+	return hex.EncodeToString(sum[:8])
+}
+
+func (m *MailboxScanner) debug(msg string, args ...interface{}) {
+	if m.logger != nil {
+		m.logger.Debug(msg, args...)
+	}
+}
+
+func (m *MailboxScanner) warn(msg string, args ...interface{}) {
+	if m.logger != nil {
+		m.logger.Warn(msg, args...)
+	}
+}
@@ -0,0 +1,202 @@
+package parser
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"ArticlesScanner/internal/scanner"
+)
+
+const scholarAlertMessage = `From scholaralerts-noreply@google.com Sat Nov  8 07:12:00 2025
+From: Google Scholar Alerts <scholaralerts-noreply@google.com>
+Subject: =?UTF-8?Q?New_results_for_=22diffusion=22?=
+Date: Sat, 08 Nov 2025 07:12:00 +0000
+MIME-Version: 1.0
+Content-Type: multipart/alternative; boundary="b1"
+
+--b1
+Content-Type: text/plain; charset=UTF-8
+
+ignored when html is present
+
+--b1
+Content-Type: text/html; charset=UTF-8
+Content-Transfer-Encoding: quoted-printable
+
+<h3><a class=3D"gse_alrt_title" href=3D"https://scholar.google.com/scholar_=
+url?url=3Dhttps://arxiv.org/abs/2511.01234v2&amp;hl=3Den">Diffusion <b>models</b> for proteins</a></h3>
+<div style=3D"color:#006621">A Lee, B Chen - arXiv preprint arXiv:2511.01234, 2025</div>
+<div class=3D"gse_alrt_sni">We study diffusion&#8230;</div>
+<h3><a class=3D"gse_alrt_title" href=3D"https://scholar.google.com/scholar_url?url=3Dhttps://doi.org/10.1000/XYZ.1">Second paper</a></h3>
+<div class=3D"gse_alrt_sni">Snippet two</div>
+--b1--
+
+From journals@example.org Fri Nov  7 09:00:00 2025
+From: TOC <journals@example.org>
+Subject: Yesterday
+Date: Fri, 07 Nov 2025 09:00:00 +0000
+Content-Type: text/plain
+
+Old paper title that should be skipped
+https://doi.org/10.1000/old
+
+From rg@researchgate.net Sat Nov  8 10:00:00 2025
+From: ResearchGate <no-reply@researchgate.net>
+Subject: New publication
+Date: Sat, 08 Nov 2025 10:00:00 +0000
+Content-Type: text/html; charset=iso-8859-1
+Content-Transfer-Encoding: base64
+
+`
+
+func TestMailboxScannerMbox(t *testing.T) {
+	t.Parallel()
+
+	html := `<p><a href="https://www.researchgate.net/publication/123456_Caf_study?_tp=abc">Caf` + "\xe9" + ` study of protein folding</a> <a href="https://www.researchgate.net/publication/123456_Caf_study">PDF</a></p>`
+	mbox := scholarAlertMessage + base64Lines(html) + "\n"
+
+	path := filepath.Join(t.TempDir(), "alerts.mbox")
+	if err := os.WriteFile(path, []byte(mbox), 0o600); err != nil {
+		t.Fatalf("write mbox: %v", err)
+	}
+
+	sc := NewMailboxScanner(nil)
+	req := scanner.Request{
+		Day:      time.Date(2025, time.November, 8, 12, 0, 0, 0, time.UTC),
+		SiteName: "alerts",
+		Options:  map[string]string{"path": path, "allMessages": "false"},
+	}
+
+	articles, err := sc.Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+	if len(articles) != 3 {
+		t.Fatalf("expected 3 articles, got %d: %+v", len(articles), articles)
+	}
+
+	// By default alerts from other days, e.g. received after the previous run, are read as well.
+	req.Options = map[string]string{"path": path}
+	all, err := sc.Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan all error: %v", err)
+	}
+	if len(all) != 4 || all[2].ID != "doi:10.1000/old" {
+		t.Fatalf("expected the earlier alert by default, got %+v", all)
+	}
+
+	first := articles[0]
+	if first.ID != "arXiv:2511.01234" || first.URL != "https://arxiv.org/abs/2511.01234v2" || first.Title != "Diffusion models for proteins" {
+		t.Fatalf("unexpected scholar article: %+v", first)
+	}
+	if len(first.Authors) != 2 || first.Authors[1] != "B Chen" || first.Abstract != "We study diffusion…" || first.Source != "alerts/inbox" {
+		t.Fatalf("unexpected scholar metadata: %+v", first)
+	}
+	if articles[1].ID != "doi:10.1000/xyz.1" || articles[1].DOI != "10.1000/XYZ.1" {
+		t.Fatalf("unexpected doi article: %+v", articles[1])
+	}
+	rg := articles[2]
+	if rg.ID != "researchgate:123456" || rg.Title != "Café study of protein folding" || rg.URL != "https://www.researchgate.net/publication/123456_Caf_study" {
+		t.Fatalf("unexpected researchgate article: %+v", rg)
+	}
+}
+
+func TestMailboxScannerMaildirPlainText(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	for _, sub := range []string{"new", "cur", "tmp"} {
+		if err := os.MkdirAll(filepath.Join(root, sub), 0o700); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+	}
+	message := strings.Join([]string{
+		"From: Nature <alerts@nature.com>",
+		"Subject: Nature table of contents",
+		"Date: Sat, 08 Nov 2025 05:00:00 +0000",
+		"Content-Type: text/plain; charset=utf-8",
+		"Content-Transfer-Encoding: quoted-printable",
+		"",
+		"Articles",
+		"A long and informative article title on ice sheets",
+		"https://www.nature.com/articles/s41586-025-0001",
+		"Unsubscribe https://www.nature.com/unsubscribe",
+		"",
+	}, "\r\n")
+	if err := os.WriteFile(filepath.Join(root, "cur", "1.eml:2,S"), []byte(message), 0o600); err != nil {
+		t.Fatalf("write message: %v", err)
+	}
+
+	sc := NewMailboxScanner(nil)
+	req := scanner.Request{
+		Day:        time.Date(2025, time.November, 8, 0, 0, 0, 0, time.UTC),
+		SiteName:   "alerts",
+		Categories: []scanner.Category{{Name: "nature", URL: root}},
+	}
+
+	articles, err := sc.Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+	if len(articles) != 1 {
+		t.Fatalf("expected 1 article, got %d: %+v", len(articles), articles)
+	}
+	if got := articles[0]; got.Title != "A long and informative article title on ice sheets" || !strings.HasPrefix(got.ID, "link:") {
+		t.Fatalf("unexpected article: %+v", got)
+	}
+}
+
+func base64Lines(value string) string {
+	encoded := base64.StdEncoding.EncodeToString([]byte(value))
+	var b strings.Builder
+	for len(encoded) > 76 {
+		b.WriteString(encoded[:76] + "\n")
+		encoded = encoded[76:]
+	}
+	b.WriteString(encoded + "\n")
+	return b.String()
+}
+
+func TestParseMailMessageCharsets(t *testing.T) {
+	t.Parallel()
+
+	message := func(charset, body string) []byte {
+		return []byte(strings.Join([]string{
+			"From: TOC <toc@example.org>",
+			"Subject: New issue",
+			"Date: Sat, 08 Nov 2025 05:00:00 +0000",
+			"Content-Type: text/plain; charset=" + charset,
+			"",
+			body,
+			"",
+		}, "\r\n"))
+	}
+
+	cases := []struct {
+		charset, body, want string
+		warned              bool
+	}{
+		{charset: "windows-1252", body: "\x93Smart\x94 quotes \x96 cost \x80", want: "“Smart” quotes – cost €"},
+		{charset: "iso-8859-1", body: "Caf\xe9", want: "Café"},
+		{charset: "windows-1251", body: "\xcf\xf0\xe8\xe2\xe5\xf2", want: "Привет"},
+		{charset: "koi8-r", body: "\xf0\xd2\xc9\xd7\xc5\xd4", want: "Привет"},
+		{charset: "x-unknown", body: "https://doi.org/10.1000/raw", want: "https://doi.org/10.1000/raw", warned: true},
+	}
+	for _, tc := range cases {
+		msg, err := parseMailMessage(message(tc.charset, tc.body))
+		if err != nil {
+			t.Fatalf("%s: parse error: %v", tc.charset, err)
+		}
+		if !strings.Contains(msg.Text, tc.want) {
+			t.Fatalf("%s: got %q, want %q", tc.charset, msg.Text, tc.want)
+		}
+		if warned := len(msg.Warnings) > 0; warned != tc.warned {
+			t.Fatalf("%s: unexpected warnings %v", tc.charset, msg.Warnings)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package parser
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/infrastructure/oaipmh"
+	"ArticlesScanner/internal/scanner"
+)
+
+// OAIScanner harvests any OAI-PMH repository in Dublin Core (oai_dc).
+type OAIScanner struct {
+	client *http.Client
+	logger *slog.Logger
+}
+
+// NewOAIScanner wires an HTTP client; nil falls back to a client with a sane timeout.
+func NewOAIScanner(client *http.Client, log *slog.Logger) *OAIScanner {
+	if client == nil {
+		client = &http.Client{Timeout: 60 * time.Second}
+	}
+	return &OAIScanner{client: client, logger: log}
+}
+
+// Name identifies the strategy inside the registry.
+func (o *OAIScanner) Name() string {
+	return "oai"
+}
+
+// Scan runs ListRecords for the requested day against each category URL, the repository base URL.
+//
+// A "set" query parameter on the URL (https://repo.example/oai?set=com_123) selects the OAI set and is
+// stripped before harvesting; option "set" applies to categories without one.
+func (o *OAIScanner) Scan(ctx context.Context, req scanner.Request) ([]domain.Article, error) {
+	if len(req.Categories) == 0 {
+		return nil, fmt.Errorf("no categories provided for site %s", req.SiteName)
+	}
+	day := req.Day.Format("2006-01-02")
+
+	o.debug("scan start", "site", req.SiteName, "repositories", len(req.Categories), "target_day", day)
+
+	results := make([]domain.Article, 0)
+	seen := map[string]struct{}{}
+	for _, cat := range req.Categories {
+		baseURL, set, err := oaiTarget(cat.URL, req.Options["set"])
+		if err != nil {
+			return nil, fmt.Errorf("category %s: %w", cat.Name, err)
+		}
+
+		source := articleSource(req.SiteName, cat.Name)
+		opts := oaipmh.ListRecordsOptions{MetadataPrefix: oaipmh.MetadataPrefixDC, Set: set, From: day, Until: day}
+		matched := 0
+		err = oaipmh.NewClient(baseURL, o.client, o.logger).ListRecords(ctx, opts, func(record oaipmh.Record) error {
+			if record.Header.Deleted() {
+				return nil
+			}
+			dc, ok, err := record.DublinCore()
+			if err != nil || !ok {
+				return err
+			}
+			article, ok := dublinCoreArticle(record.Header, dc, req.Day.Location(), source)
+			if !ok {
+				return nil
+			}
+			if _, dup := seen[article.ID]; dup {
+				return nil
+			}
+			seen[article.ID] = struct{}{}
+			results = append(results, article)
+			matched++
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("category %s: %w", cat.Name, err)
+		}
+		o.debug("category processed", "category", cat.Name, "set", set, "articles", matched)
+	}
+
+	o.debug("scan finished", "site", req.SiteName, "total", len(results))
+	return results, nil
+}
+
+// oaiTarget splits a category URL into the repository base URL and its set.
+func oaiTarget(raw, fallbackSet string) (string, string, error) {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return "", "", fmt.Errorf("url must be an OAI-PMH base URL, got %q", raw)
+	}
+	query := parsed.Query()
+	set := query.Get("set")
+	if set == "" {
+		set = fallbackSet
+	}
+	query.Del("set")
+	parsed.RawQuery = query.Encode()
+	return parsed.String(), set, nil
+}
+
+// dublinCoreArticle maps oai_dc onto an article, preferring a DOI identity over the OAI identifier.
+func dublinCoreArticle(header oaipmh.Header, dc oaipmh.DublinCore, loc *time.Location, source string) (domain.Article, bool) {
+	title := htmlText(oaipmh.First(dc.Title))
+	if title == "" {
+		return domain.Article{}, false
+	}
+
+	article := domain.Article{
+		ID:       strings.TrimSpace(header.Identifier),
+		Title:    title,
+		Abstract: htmlText(strings.Join(dc.Description, "\n")),
+		Source:   source,
+		Venue:    collapseSpaces(oaipmh.First(dc.Source)),
+	}
+	for _, creator := range dc.Creator {
+		if name := invertName(creator); name != "" {
+			article.Authors = append(article.Authors, name)
+		}
+	}
+	for _, subject := range dc.Subject {
+		if subject = collapseSpaces(subject); subject != "" {
+			article.Keywords = append(article.Keywords, subject)
+		}
+	}
+
+	for _, identifier := range append(append([]string(nil), dc.Identifier...), dc.Relation...) {
+		identifier = strings.TrimSpace(identifier)
+		if article.DOI == "" {
+			article.DOI = findDOI(identifier)
+		}
+		if !strings.HasPrefix(identifier, "http") {
+			continue
+		}
+		if strings.HasSuffix(strings.ToLower(identifier), ".pdf") {
+			if article.PDFURL == "" {
+				article.PDFURL = identifier
+			}
+		} else if article.URL == "" {
+			article.URL = identifier
+		}
+	}
+	if article.DOI != "" {
+		article.ID = doiArticleID(article.DOI)
+		if article.URL == "" {
+			article.URL = "https://doi.org/" + article.DOI
+		}
+	}
+	if article.ID == "" {
+		return domain.Article{}, false
+	}
+
+	if datestamp, ok := parseFeedDate(header.Datestamp, loc); ok {
+		article.PublishedAt = datestamp
+	}
+	return article, true
+}
+
+func (o *OAIScanner) debug(msg string, args ...interface{}) {
+	if o.logger != nil {
+		o.logger.Debug(msg, args...)
+	}
+}
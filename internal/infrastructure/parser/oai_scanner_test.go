@@ -0,0 +1,83 @@
+package parser
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"ArticlesScanner/internal/scanner"
+)
+
+func TestOAITarget(t *testing.T) {
+	t.Parallel()
+
+	base, set, err := oaiTarget("https://repo.example/oai/request?set=com_1&token=x", "")
+	if err != nil || base != "https://repo.example/oai/request?token=x" || set != "com_1" {
+		t.Fatalf("unexpected target: %s %s %v", base, set, err)
+	}
+	if _, set, _ := oaiTarget("https://repo.example/oai", "fallback"); set != "fallback" {
+		t.Fatalf("expected fallback set, got %s", set)
+	}
+	if _, _, err := oaiTarget("not a url", ""); err == nil {
+		t.Fatal("expected error for invalid base url")
+	}
+}
+
+func TestOAIScannerScan(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/oai" || q.Get("verb") != "ListRecords" || q.Get("metadataPrefix") != "oai_dc" || q.Get("set") != "hdl_1" || q.Get("from") != "2025-11-08" {
+			t.Errorf("unexpected request: %s", r.URL.String())
+		}
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+		<OAI-PMH xmlns="http://www.openarchives.org/OAI/2.0/"><ListRecords>
+		  <record><header><identifier>oai:repo:1</identifier><datestamp>2025-11-08T10:00:00Z</datestamp></header>
+		    <metadata><oai_dc:dc xmlns:oai_dc="http://www.openarchives.org/OAI/2.0/oai_dc/" xmlns:dc="http://purl.org/dc/elements/1.1/">
+		      <dc:title>Coral reef &lt;i&gt;resilience&lt;/i&gt;</dc:title>
+		      <dc:creator>Lee, Ann</dc:creator>
+		      <dc:subject>Ecology</dc:subject>
+		      <dc:description>First paragraph.</dc:description>
+		      <dc:identifier>https://repo.example/handle/1</dc:identifier>
+		      <dc:identifier>https://repo.example/bitstream/1/paper.pdf</dc:identifier>
+		      <dc:identifier>doi:10.1234/REEF.9</dc:identifier>
+		      <dc:source>Marine Letters</dc:source>
+		    </oai_dc:dc></metadata></record>
+		  <record><header><identifier>oai:repo:2</identifier><datestamp>2025-11-08</datestamp></header>
+		    <metadata><oai_dc:dc xmlns:oai_dc="http://www.openarchives.org/OAI/2.0/oai_dc/" xmlns:dc="http://purl.org/dc/elements/1.1/">
+		      <dc:title>Thesis without DOI</dc:title>
+		    </oai_dc:dc></metadata></record>
+		  <record><header status="deleted"><identifier>oai:repo:3</identifier><datestamp>2025-11-08</datestamp></header></record>
+		</ListRecords></OAI-PMH>`))
+	}))
+	defer server.Close()
+
+	sc := NewOAIScanner(server.Client(), nil)
+	req := scanner.Request{
+		Day:        time.Date(2025, time.November, 8, 0, 0, 0, 0, time.UTC),
+		SiteName:   "repos",
+		Categories: []scanner.Category{{Name: "marine", URL: server.URL + "/oai?set=hdl_1"}},
+	}
+
+	articles, err := sc.Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+	if len(articles) != 2 {
+		t.Fatalf("expected 2 articles, got %d", len(articles))
+	}
+
+	first := articles[0]
+	if first.ID != "doi:10.1234/reef.9" || first.Title != "Coral reef resilience" || first.URL != "https://repo.example/handle/1" {
+		t.Fatalf("unexpected identity: %+v", first)
+	}
+	if first.PDFURL != "https://repo.example/bitstream/1/paper.pdf" || first.Venue != "Marine Letters" || first.Authors[0] != "Ann Lee" || first.Keywords[0] != "Ecology" {
+		t.Fatalf("unexpected metadata: %+v", first)
+	}
+	if articles[1].ID != "oai:repo:2" || articles[1].Source != "repos/marine" {
+		t.Fatalf("unexpected fallback identity: %+v", articles[1])
+	}
+}
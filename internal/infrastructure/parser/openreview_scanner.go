@@ -0,0 +1,197 @@
+package parser
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/scanner"
+)
+
+const (
+	openReviewEndpoint = "https://api2.openreview.net"
+	openReviewSite     = "https://openreview.net"
+	openReviewPageSize = 1000
+	openReviewMaxPages = 50
+)
+
+// OpenReviewScanner pulls venue submissions from the OpenReview API v2 notes endpoint.
+type OpenReviewScanner struct {
+	client   *http.Client
+	pageSize int
+	logger   *slog.Logger
+}
+
+// NewOpenReviewScanner wires an HTTP client; pageSize defaults to the API maximum of 1000.
+func NewOpenReviewScanner(client *http.Client, log *slog.Logger) *OpenReviewScanner {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &OpenReviewScanner{client: client, pageSize: openReviewPageSize, logger: log}
+}
+
+// Name identifies the strategy inside the registry.
+func (o *OpenReviewScanner) Name() string {
+	return "openreview"
+}
+
+// Scan lists notes for each venue invitation (category URL, e.g. ICLR.cc/2025/Conference/-/Submission)
+// newest modification first and keeps notes created or modified on the requested day.
+// Option "endpoint" overrides the API host.
+func (o *OpenReviewScanner) Scan(ctx context.Context, req scanner.Request) ([]domain.Article, error) {
+	if len(req.Categories) == 0 {
+		return nil, fmt.Errorf("no categories provided for site %s", req.SiteName)
+	}
+	endpoint := strings.TrimSuffix(optionOr(req.Options, "endpoint", openReviewEndpoint), "/")
+
+	o.debug("scan start", "site", req.SiteName, "venues", len(req.Categories), "target_day", req.Day.Format("2006-01-02"))
+
+	results := make([]domain.Article, 0)
+	seen := map[string]struct{}{}
+	for _, cat := range req.Categories {
+		invitation := strings.TrimSpace(cat.URL)
+		if invitation == "" {
+			return nil, fmt.Errorf("category %s: venue invitation is required in url", cat.Name)
+		}
+
+		articles, err := o.scanInvitation(ctx, endpoint, invitation, req.Day, articleSource(req.SiteName, cat.Name))
+		if err != nil {
+			return nil, fmt.Errorf("category %s: %w", cat.Name, err)
+		}
+		for _, article := range articles {
+			if _, ok := seen[article.ID]; ok {
+				continue
+			}
+			seen[article.ID] = struct{}{}
+			results = append(results, article)
+		}
+	}
+
+	o.debug("scan finished", "site", req.SiteName, "total", len(results))
+	return results, nil
+}
+
+func (o *OpenReviewScanner) scanInvitation(ctx context.Context, endpoint, invitation string, day time.Time, source string) ([]domain.Article, error) {
+	dayStart := startOfDay(day)
+
+	var collected []domain.Article
+	for page := 0; page < openReviewMaxPages; page++ {
+		query := url.Values{}
+		query.Set("invitation", invitation)
+		query.Set("offset", strconv.Itoa(page*o.pageSize))
+		query.Set("limit", strconv.Itoa(o.pageSize))
+		query.Set("sort", "mdate:desc")
+
+		pageURL := endpoint + "/notes?" + query.Encode()
+		o.debug("requesting", "url", pageURL)
+
+		body, err := fetchBody(ctx, o.client, pageURL, http.Header{"Accept": {"application/json"}})
+		if err != nil {
+			return nil, fmt.Errorf("list notes: %w", err)
+		}
+
+		var resp struct {
+			Notes []openReviewNote `json:"notes"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("decode notes: %w", err)
+		}
+
+		reachedOlder := false
+		for _, note := range resp.Notes {
+			created, modified := note.created(), note.modified()
+			if !modified.IsZero() && modified.Before(dayStart) {
+				reachedOlder = true
+				break
+			}
+			if sameDay(created, day) || sameDay(modified, day) {
+				collected = append(collected, note.toArticle(source))
+			}
+		}
+
+		o.debug("page processed", "invitation", invitation, "offset", page*o.pageSize, "notes", len(resp.Notes), "collected", len(collected))
+		if reachedOlder || len(resp.Notes) < o.pageSize {
+			break
+		}
+	}
+
+	return collected, nil
+}
+
+type openReviewNote struct {
+	ID      string `json:"id"`
+	Forum   string `json:"forum"`
+	CDate   int64  `json:"cdate"`
+	MDate   int64  `json:"mdate"`
+	TCDate  int64  `json:"tcdate"`
+	TMDate  int64  `json:"tmdate"`
+	Content struct {
+		Title    openReviewValue[string]   `json:"title"`
+		Abstract openReviewValue[string]   `json:"abstract"`
+		Keywords openReviewValue[[]string] `json:"keywords"`
+		Authors  openReviewValue[[]string] `json:"authors"`
+		PDF      openReviewValue[string]   `json:"pdf"`
+		Venue    openReviewValue[string]   `json:"venue"`
+	} `json:"content"`
+}
+
+// openReviewValue unwraps API v2 content fields, which are objects of the form {"value": ...}.
+type openReviewValue[T any] struct {
+	Value T `json:"value"`
+}
+
+func (n openReviewNote) created() time.Time {
+	return millisTime(n.CDate, n.TCDate)
+}
+
+func (n openReviewNote) modified() time.Time {
+	return millisTime(n.MDate, n.TMDate)
+}
+
+func millisTime(primary, fallback int64) time.Time {
+	if primary == 0 {
+		primary = fallback
+	}
+	if primary == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(primary)
+}
+
+func (n openReviewNote) toArticle(source string) domain.Article {
+	forum := n.Forum
+	if forum == "" {
+		forum = n.ID
+	}
+
+	pdf := strings.TrimSpace(n.Content.PDF.Value)
+	if strings.HasPrefix(pdf, "/") {
+		pdf = openReviewSite + pdf
+	}
+
+	return domain.Article{
+		ID:          "openreview:" + forum,
+		Title:       collapseSpaces(n.Content.Title.Value),
+		Abstract:    collapseSpaces(n.Content.Abstract.Value),
+		URL:         openReviewSite + "/forum?id=" + url.QueryEscape(forum),
+		Source:      source,
+		Authors:     n.Content.Authors.Value,
+		Venue:       n.Content.Venue.Value,
+		Keywords:    n.Content.Keywords.Value,
+		PDFURL:      pdf,
+		PublishedAt: n.created(),
+	}
+}
+
+func (o *OpenReviewScanner) debug(msg string, args ...interface{}) {
+	if o.logger != nil {
+		o.logger.Debug(msg, args...)
+	}
+}
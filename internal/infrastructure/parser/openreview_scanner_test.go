@@ -0,0 +1,89 @@
+package parser
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ArticlesScanner/internal/scanner"
+)
+
+func openReviewNoteJSON(id string, cdate, mdate time.Time) string {
+	return fmt.Sprintf(`{"id":%q,"forum":%q,"cdate":%d,"mdate":%d,"content":{
+	  "title":{"value":"Paper %s"},"abstract":{"value":"Abstract %s"},
+	  "keywords":{"value":["llm","agents"]},"authors":{"value":["Ann","Bob"]},
+	  "pdf":{"value":"/pdf/%s.pdf"},"venue":{"value":"ICLR 2026 Conference Submission"}}}`,
+		id, id, cdate.UnixMilli(), mdate.UnixMilli(), id, id, id)
+}
+
+func TestOpenReviewScannerScan(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2025, time.November, 8, 0, 0, 0, 0, time.UTC)
+
+	var offsets []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/notes" || q.Get("invitation") != "ICLR.cc/2026/Conference/-/Submission" {
+			t.Errorf("unexpected request: %s", r.URL.String())
+		}
+		offsets = append(offsets, q.Get("offset"))
+
+		var notes []string
+		switch q.Get("offset") {
+		case "0":
+			notes = []string{
+				openReviewNoteJSON("tomorrow", day.Add(30*time.Hour), day.Add(30*time.Hour)),
+				openReviewNoteJSON("revised", day.Add(-72*time.Hour), day.Add(10*time.Hour)),
+			}
+		case "2":
+			notes = []string{
+				openReviewNoteJSON("fresh", day.Add(2*time.Hour), day.Add(3*time.Hour)),
+				openReviewNoteJSON("stale", day.Add(-48*time.Hour), day.Add(-47*time.Hour)),
+			}
+		default:
+			t.Errorf("paged past older notes: offset=%s", q.Get("offset"))
+		}
+		_, _ = fmt.Fprintf(w, `{"notes":[%s],"count":4}`, strings.Join(notes, ","))
+	}))
+	defer server.Close()
+
+	sc := NewOpenReviewScanner(server.Client(), nil)
+	sc.pageSize = 2
+
+	req := scanner.Request{
+		Day:        day.Add(6 * time.Hour),
+		SiteName:   "openreview",
+		Options:    map[string]string{"endpoint": server.URL},
+		Categories: []scanner.Category{{Name: "iclr2026", URL: "ICLR.cc/2026/Conference/-/Submission"}},
+	}
+
+	articles, err := sc.Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+	if len(offsets) != 2 {
+		t.Fatalf("expected 2 pages, got %v", offsets)
+	}
+	if len(articles) != 2 {
+		t.Fatalf("expected 2 articles, got %d", len(articles))
+	}
+
+	revised := articles[0]
+	if revised.ID != "openreview:revised" || revised.URL != "https://openreview.net/forum?id=revised" {
+		t.Fatalf("unexpected identity: %s %s", revised.ID, revised.URL)
+	}
+	if revised.PDFURL != "https://openreview.net/pdf/revised.pdf" || len(revised.Keywords) != 2 {
+		t.Fatalf("unexpected pdf/keywords: %s %v", revised.PDFURL, revised.Keywords)
+	}
+	if revised.Source != "openreview/iclr2026" || revised.Venue != "ICLR 2026 Conference Submission" {
+		t.Fatalf("unexpected source/venue: %s %s", revised.Source, revised.Venue)
+	}
+	if articles[1].Title != "Paper fresh" || len(articles[1].Authors) != 2 {
+		t.Fatalf("unexpected fresh article: %+v", articles[1])
+	}
+}
@@ -0,0 +1,311 @@
+package parser
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/scanner"
+)
+
+const (
+	pubmedEndpoint  = "https://eutils.ncbi.nlm.nih.gov/entrez/eutils"
+	pubmedBaseURL   = "https://pubmed.ncbi.nlm.nih.gov/"
+	pubmedPageSize  = 500
+	pubmedBatchSize = 200
+)
+
+// PubMedScanner searches PubMed through the NCBI E-utilities (esearch + efetch).
+type PubMedScanner struct {
+	client    *http.Client
+	pageSize  int
+	batchSize int
+	logger    *slog.Logger
+}
+
+// NewPubMedScanner wires an HTTP client; esearch pages hold 500 IDs and efetch batches 200.
+func NewPubMedScanner(client *http.Client, log *slog.Logger) *PubMedScanner {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &PubMedScanner{client: client, pageSize: pubmedPageSize, batchSize: pubmedBatchSize, logger: log}
+}
+
+// Name identifies the strategy inside the registry.
+func (p *PubMedScanner) Name() string {
+	return "pubmed"
+}
+
+// Scan runs one esearch per query restricted to the requested day and fetches the matching records.
+//
+// Category name labels a query and its URL holds the search term (falling back to the "query" option).
+// Options "api_key", "tool" and "email" are forwarded to NCBI; "endpoint" and "datetype" (edat) are optional.
+func (p *PubMedScanner) Scan(ctx context.Context, req scanner.Request) ([]domain.Article, error) {
+	queries := req.Categories
+	if len(queries) == 0 {
+		queries = []scanner.Category{{URL: req.Options["query"]}}
+	}
+
+	endpoint := strings.TrimSuffix(optionOr(req.Options, "endpoint", pubmedEndpoint), "/")
+	common := url.Values{}
+	common.Set("db", "pubmed")
+	for _, key := range []string{"api_key", "tool", "email"} {
+		if v := strings.TrimSpace(req.Options[key]); v != "" {
+			common.Set(key, v)
+		}
+	}
+
+	p.debug("scan start", "site", req.SiteName, "queries", len(queries), "target_day", req.Day.Format("2006-01-02"))
+
+	results := make([]domain.Article, 0)
+	seen := map[string]struct{}{}
+	for _, q := range queries {
+		term := strings.TrimSpace(q.URL)
+		if term == "" {
+			term = strings.TrimSpace(req.Options["query"])
+		}
+		if term == "" {
+			return nil, fmt.Errorf("no search term for site %s category %s", req.SiteName, q.Name)
+		}
+
+		pmids, err := p.search(ctx, endpoint, common, term, req)
+		if err != nil {
+			return nil, fmt.Errorf("category %s: %w", q.Name, err)
+		}
+
+		fresh := make([]string, 0, len(pmids))
+		for _, pmid := range pmids {
+			if _, ok := seen[pmid]; ok {
+				continue
+			}
+			seen[pmid] = struct{}{}
+			fresh = append(fresh, pmid)
+		}
+
+		for start := 0; start < len(fresh); start += p.batchSize {
+			end := min(start+p.batchSize, len(fresh))
+			articles, err := p.fetch(ctx, endpoint, common, fresh[start:end], req.Day, articleSource(req.SiteName, q.Name))
+			if err != nil {
+				return nil, fmt.Errorf("category %s: %w", q.Name, err)
+			}
+			results = append(results, articles...)
+		}
+		p.debug("query processed", "category", q.Name, "pmids", len(pmids), "fetched", len(fresh))
+	}
+
+	p.debug("scan finished", "site", req.SiteName, "total", len(results))
+	return results, nil
+}
+
+func (p *PubMedScanner) search(ctx context.Context, endpoint string, common url.Values, term string, req scanner.Request) ([]string, error) {
+	day := req.Day.Format("2006/01/02")
+
+	var ids []string
+	for start := 0; ; start += p.pageSize {
+		query := cloneValues(common)
+		query.Set("term", term)
+		query.Set("datetype", optionOr(req.Options, "datetype", "edat"))
+		query.Set("mindate", day)
+		query.Set("maxdate", day)
+		query.Set("retmode", "json")
+		query.Set("retstart", strconv.Itoa(start))
+		query.Set("retmax", strconv.Itoa(p.pageSize))
+
+		pageURL, err := withQuery(endpoint+"/esearch.fcgi", query)
+		if err != nil {
+			return nil, err
+		}
+		p.debug("requesting", "url", pageURL)
+
+		body, err := fetchBody(ctx, p.client, pageURL, nil)
+		if err != nil {
+			return nil, fmt.Errorf("esearch: %w", err)
+		}
+
+		var resp struct {
+			Result struct {
+				Count  string   `json:"count"`
+				IDList []string `json:"idlist"`
+			} `json:"esearchresult"`
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("decode esearch: %w", err)
+		}
+		if resp.Error != "" {
+			return nil, fmt.Errorf("esearch error: %s", resp.Error)
+		}
+
+		ids = append(ids, resp.Result.IDList...)
+		total, _ := strconv.Atoi(resp.Result.Count)
+		if len(resp.Result.IDList) == 0 || len(ids) >= total {
+			return ids, nil
+		}
+	}
+}
+
+func (p *PubMedScanner) fetch(ctx context.Context, endpoint string, common url.Values, pmids []string, day time.Time, source string) ([]domain.Article, error) {
+	query := cloneValues(common)
+	query.Set("id", strings.Join(pmids, ","))
+	query.Set("retmode", "xml")
+
+	fetchURL, err := withQuery(endpoint+"/efetch.fcgi", query)
+	if err != nil {
+		return nil, err
+	}
+	p.debug("requesting", "url", fetchURL, "ids", len(pmids))
+
+	body, err := fetchBody(ctx, p.client, fetchURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("efetch: %w", err)
+	}
+
+	var set pubmedArticleSet
+	if err := xml.Unmarshal(body, &set); err != nil {
+		return nil, fmt.Errorf("decode efetch: %w", err)
+	}
+
+	articles := make([]domain.Article, 0, len(set.Articles))
+	for _, record := range set.Articles {
+		if article, ok := record.toArticle(day, source); ok {
+			articles = append(articles, article)
+		}
+	}
+	return articles, nil
+}
+
+func cloneValues(values url.Values) url.Values {
+	clone := make(url.Values, len(values))
+	for key, v := range values {
+		clone[key] = append([]string(nil), v...)
+	}
+	return clone
+}
+
+type pubmedArticleSet struct {
+	Articles []pubmedArticle `xml:"PubmedArticle"`
+}
+
+type pubmedArticle struct {
+	Citation struct {
+		PMID    string `xml:"PMID"`
+		Article struct {
+			Journal struct {
+				Title string `xml:"Title"`
+			} `xml:"Journal"`
+			Title    innerXML `xml:"ArticleTitle"`
+			Abstract struct {
+				Sections []struct {
+					Label string `xml:"Label,attr"`
+					Text  string `xml:",innerxml"`
+				} `xml:"AbstractText"`
+			} `xml:"Abstract"`
+			Authors []struct {
+				LastName       string `xml:"LastName"`
+				ForeName       string `xml:"ForeName"`
+				CollectiveName string `xml:"CollectiveName"`
+			} `xml:"AuthorList>Author"`
+			ELocations []struct {
+				Type  string `xml:"EIdType,attr"`
+				Value string `xml:",chardata"`
+			} `xml:"ELocationID"`
+		} `xml:"Article"`
+	} `xml:"MedlineCitation"`
+	Data struct {
+		History []struct {
+			Status string `xml:"PubStatus,attr"`
+			Year   int    `xml:"Year"`
+			Month  int    `xml:"Month"`
+			Day    int    `xml:"Day"`
+		} `xml:"History>PubMedPubDate"`
+		IDs []struct {
+			Type  string `xml:"IdType,attr"`
+			Value string `xml:",chardata"`
+		} `xml:"ArticleIdList>ArticleId"`
+	} `xml:"PubmedData"`
+}
+
+type innerXML struct {
+	Value string `xml:",innerxml"`
+}
+
+func (a pubmedArticle) toArticle(day time.Time, source string) (domain.Article, bool) {
+	pmid := strings.TrimSpace(a.Citation.PMID)
+	if pmid == "" {
+		return domain.Article{}, false
+	}
+	meta := a.Citation.Article
+
+	sections := make([]string, 0, len(meta.Abstract.Sections))
+	for _, section := range meta.Abstract.Sections {
+		text := htmlText(section.Text)
+		if text == "" {
+			continue
+		}
+		if label := strings.TrimSpace(section.Label); label != "" {
+			text = label + ": " + text
+		}
+		sections = append(sections, text)
+	}
+
+	authors := make([]string, 0, len(meta.Authors))
+	for _, author := range meta.Authors {
+		name := strings.TrimSpace(author.ForeName + " " + author.LastName)
+		if name == "" {
+			name = strings.TrimSpace(author.CollectiveName)
+		}
+		if name != "" {
+			authors = append(authors, name)
+		}
+	}
+
+	var doi string
+	for _, id := range a.Data.IDs {
+		if id.Type == "doi" {
+			doi = strings.TrimSpace(id.Value)
+			break
+		}
+	}
+	if doi == "" {
+		for _, loc := range meta.ELocations {
+			if loc.Type == "doi" {
+				doi = strings.TrimSpace(loc.Value)
+				break
+			}
+		}
+	}
+
+	publishedAt := startOfDay(day)
+	for _, h := range a.Data.History {
+		if (h.Status == "entrez" || h.Status == "pubmed") && h.Year > 0 {
+			publishedAt = time.Date(h.Year, time.Month(h.Month), h.Day, 0, 0, 0, 0, day.Location())
+			break
+		}
+	}
+
+	return domain.Article{
+		ID:          "pmid:" + pmid,
+		Title:       htmlText(meta.Title.Value),
+		Abstract:    strings.Join(sections, "\n"),
+		URL:         pubmedBaseURL + pmid + "/",
+		Source:      source,
+		Authors:     authors,
+		DOI:         doi,
+		Venue:       collapseSpaces(meta.Journal.Title),
+		PublishedAt: publishedAt,
+	}, true
+}
+
+func (p *PubMedScanner) debug(msg string, args ...interface{}) {
+	if p.logger != nil {
+		p.logger.Debug(msg, args...)
+	}
+}
@@ -0,0 +1,130 @@
+package parser
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"ArticlesScanner/internal/scanner"
+)
+
+const pubmedEfetchXML = `<?xml version="1.0"?>
+<PubmedArticleSet>
+  <PubmedArticle>
+    <MedlineCitation>
+      <PMID Version="1">40000001</PMID>
+      <Article>
+        <Journal><Title>The Lancet</Title></Journal>
+        <ArticleTitle>CRISPR in <i>vivo</i> editing.</ArticleTitle>
+        <Abstract>
+          <AbstractText Label="BACKGROUND">Gene editing matters.</AbstractText>
+          <AbstractText Label="RESULTS">It <b>works</b>.</AbstractText>
+        </Abstract>
+        <AuthorList>
+          <Author><LastName>Doe</LastName><ForeName>Jane</ForeName></Author>
+          <Author><CollectiveName>CRISPR Consortium</CollectiveName></Author>
+        </AuthorList>
+        <ELocationID EIdType="pii">S0140-6736</ELocationID>
+      </Article>
+    </MedlineCitation>
+    <PubmedData>
+      <History>
+        <PubMedPubDate PubStatus="entrez"><Year>2025</Year><Month>11</Month><Day>8</Day></PubMedPubDate>
+      </History>
+      <ArticleIdList>
+        <ArticleId IdType="pubmed">40000001</ArticleId>
+        <ArticleId IdType="doi">10.1016/S0140-6736(25)00001-1</ArticleId>
+      </ArticleIdList>
+    </PubmedData>
+  </PubmedArticle>
+  <PubmedArticle>
+    <MedlineCitation>
+      <PMID Version="1">40000002</PMID>
+      <Article>
+        <Journal><Title>Nature Medicine</Title></Journal>
+        <ArticleTitle>Second</ArticleTitle>
+        <ELocationID EIdType="doi">10.1038/s41591-025-00002-2</ELocationID>
+      </Article>
+    </MedlineCitation>
+  </PubmedArticle>
+</PubmedArticleSet>`
+
+func TestPubMedScannerScan(t *testing.T) {
+	t.Parallel()
+
+	var fetchedIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("db") != "pubmed" || q.Get("api_key") != "secret" || q.Get("tool") != "articlescanner" || q.Get("email") != "ops@example.org" {
+			t.Errorf("missing common parameters: %s", r.URL.RawQuery)
+		}
+
+		switch r.URL.Path {
+		case "/eutils/esearch.fcgi":
+			if q.Get("term") != "crispr[tiab]" || q.Get("mindate") != "2025/11/08" || q.Get("maxdate") != "2025/11/08" {
+				t.Errorf("unexpected esearch query: %s", r.URL.RawQuery)
+			}
+			if q.Get("retstart") == "0" {
+				_, _ = w.Write([]byte(`{"esearchresult":{"count":"3","idlist":["40000001","40000002"]}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"esearchresult":{"count":"3","idlist":["40000001"]}}`))
+		case "/eutils/efetch.fcgi":
+			fetchedIDs = append(fetchedIDs, q.Get("id"))
+			_, _ = w.Write([]byte(pubmedEfetchXML))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	sc := NewPubMedScanner(server.Client(), nil)
+	sc.pageSize = 2
+
+	req := scanner.Request{
+		Day:      time.Date(2025, time.November, 8, 6, 0, 0, 0, time.UTC),
+		SiteName: "pubmed",
+		Options: map[string]string{
+			"endpoint": server.URL + "/eutils",
+			"api_key":  "secret",
+			"tool":     "articlescanner",
+			"email":    "ops@example.org",
+		},
+		Categories: []scanner.Category{{Name: "crispr", URL: "crispr[tiab]"}},
+	}
+
+	articles, err := sc.Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+
+	if len(fetchedIDs) != 1 || fetchedIDs[0] != "40000001,40000002" {
+		t.Fatalf("expected one deduplicated efetch batch, got %v", fetchedIDs)
+	}
+	if len(articles) != 2 {
+		t.Fatalf("expected 2 articles, got %d", len(articles))
+	}
+
+	first := articles[0]
+	if first.ID != "pmid:40000001" || first.URL != "https://pubmed.ncbi.nlm.nih.gov/40000001/" {
+		t.Fatalf("unexpected identity: %s %s", first.ID, first.URL)
+	}
+	if first.Title != "CRISPR in vivo editing." {
+		t.Fatalf("unexpected title: %s", first.Title)
+	}
+	if first.Abstract != "BACKGROUND: Gene editing matters.\nRESULTS: It works." {
+		t.Fatalf("unexpected abstract: %q", first.Abstract)
+	}
+	if first.DOI != "10.1016/S0140-6736(25)00001-1" || first.Venue != "The Lancet" {
+		t.Fatalf("unexpected doi/venue: %s %s", first.DOI, first.Venue)
+	}
+	if strings.Join(first.Authors, ";") != "Jane Doe;CRISPR Consortium" {
+		t.Fatalf("unexpected authors: %v", first.Authors)
+	}
+	if articles[1].DOI != "10.1038/s41591-025-00002-2" {
+		t.Fatalf("expected ELocationID doi fallback, got %s", articles[1].DOI)
+	}
+}
@@ -0,0 +1,474 @@
+package parser
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"ArticlesScanner/internal/domain"
+)
+
+var (
+	risLineExpr      = regexp.MustCompile(`^([A-Z][A-Z0-9])  -\s?(.*)$`)
+	bibAuthorSepExpr = regexp.MustCompile(`(?i)\s+and\s+`)
+	latexEscapeExpr  = regexp.MustCompile(`\\([&%$#_{}])`)
+	yearExpr         = regexp.MustCompile(`\b(\d{4})\b`)
+)
+
+// referenceEntry is the format-neutral shape of a BibTeX, RIS or JSON reference.
+type referenceEntry struct {
+	Kind     string
+	Key      string
+	Title    string
+	Abstract string
+	URL      string
+	DOI      string
+	ArXivID  string
+	Venue    string
+	Authors  []string
+	Date     time.Time
+}
+
+// toArticle prefers DOI and arXiv identities so manual lists dedup against scanner output.
+func (e referenceEntry) toArticle(fallbackDate time.Time, source string) (domain.Article, bool) {
+	if e.Title == "" {
+		return domain.Article{}, false
+	}
+
+	article := domain.Article{
+		Title:       e.Title,
+		Abstract:    e.Abstract,
+		URL:         e.URL,
+		Source:      source,
+		Authors:     e.Authors,
+		DOI:         e.DOI,
+		Venue:       e.Venue,
+		PublishedAt: e.Date,
+	}
+	if article.PublishedAt.IsZero() {
+		article.PublishedAt = fallbackDate
+	}
+
+	switch {
+	case e.DOI != "":
+		article.ID = doiArticleID(e.DOI)
+		if article.URL == "" {
+			article.URL = "https://doi.org/" + e.DOI
+		}
+	case e.ArXivID != "":
+		article.ID = "arXiv:" + e.ArXivID
+		if article.URL == "" {
+			article.URL = strings.TrimSuffix(arxivBaseURL, "/") + "/abs/" + e.ArXivID
+		}
+	case e.Key != "":
+		article.ID = e.Kind + ":" + e.Key
+	default:
+		article.ID = "link:" + linkHash(strings.ToLower(e.Title))
+	}
+	return article, true
+}
+
+// parseBibTeX reads @type{key, field = {value} | "value" | bare, ...} entries; @string macros are expanded
+// and @comment/@preamble blocks are skipped.
+func parseBibTeX(data string) ([]referenceEntry, error) {
+	var entries []referenceEntry
+	macros := map[string]string{}
+	for pos := 0; ; {
+		at := strings.IndexByte(data[pos:], '@')
+		if at < 0 {
+			return entries, nil
+		}
+		pos += at + 1
+
+		open := strings.IndexAny(data[pos:], "{(")
+		if open < 0 {
+			return entries, nil
+		}
+		entryType := strings.ToLower(strings.TrimSpace(data[pos : pos+open]))
+		opener := data[pos+open]
+		pos += open + 1
+
+		body, next, err := bibBlock(data, pos, opener)
+		if err != nil {
+			return nil, fmt.Errorf("bibtex entry @%s: %w", entryType, err)
+		}
+		pos = next
+		switch entryType {
+		case "comment", "preamble":
+			continue
+		case "string":
+			defined, err := bibFields(body, macros)
+			if err != nil {
+				return nil, fmt.Errorf("bibtex @string: %w", err)
+			}
+			for name, value := range defined {
+				macros[name] = value
+			}
+			continue
+		}
+
+		key, rest, _ := strings.Cut(body, ",")
+		fields, err := bibFields(rest, macros)
+		if err != nil {
+			return nil, fmt.Errorf("bibtex entry %s: %w", strings.TrimSpace(key), err)
+		}
+		entries = append(entries, bibEntry(strings.TrimSpace(key), fields))
+	}
+}
+
+// bibBlock returns the text up to the delimiter that closes the entry opened by opener just before
+// start. Braced and quoted field values are skipped whole, so parentheses or braces inside them
+// never close the entry.
+func bibBlock(data string, start int, opener byte) (string, int, error) {
+	closer := byte('}')
+	if opener == '(' {
+		closer = ')'
+	}
+	afterValueStart := false
+	for i := start; i < len(data); i++ {
+		switch c := data[i]; {
+		case c == closer:
+			return data[start:i], i + 1, nil
+		case c == '{':
+			end, ok := bibSkipBraces(data, i)
+			if !ok {
+				return "", 0, fmt.Errorf("unterminated entry")
+			}
+			i = end
+		case c == '"' && afterValueStart:
+			end, ok := bibSkipQuoted(data, i)
+			if !ok {
+				return "", 0, fmt.Errorf("unterminated entry")
+			}
+			i = end
+		}
+		// A quote opens a value only right after "=" or "#"; elsewhere it is plain text.
+		switch data[i] {
+		case '=', '#':
+			afterValueStart = true
+		case ' ', '\t', '\n', '\r':
+		default:
+			afterValueStart = false
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated entry")
+}
+
+// bibSkipBraces returns the index of the brace closing the group that opens at i.
+func bibSkipBraces(data string, i int) (int, bool) {
+	depth := 0
+	for ; i < len(data); i++ {
+		switch data[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// bibSkipQuoted returns the index of the quote closing the value that opens at i; quotes inside
+// braces do not count.
+func bibSkipQuoted(data string, i int) (int, bool) {
+	depth := 0
+	for i++; i < len(data); i++ {
+		switch data[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case '"':
+			if depth == 0 {
+				return i, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func bibFields(body string, macros map[string]string) (map[string]string, error) {
+	fields := map[string]string{}
+	for i := 0; i < len(body); {
+		eq := strings.IndexByte(body[i:], '=')
+		if eq < 0 {
+			break
+		}
+		name := strings.ToLower(strings.Trim(strings.TrimSpace(body[i:i+eq]), ","))
+		i += eq + 1
+
+		var parts []string
+		for {
+			for i < len(body) && (body[i] == ' ' || body[i] == '\t' || body[i] == '\n' || body[i] == '\r') {
+				i++
+			}
+			if i >= len(body) {
+				break
+			}
+			value, next, err := bibValue(body, i)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", name, err)
+			}
+			if expanded, ok := macros[strings.ToLower(value)]; ok && body[i] != '{' && body[i] != '"' {
+				value = expanded
+			}
+			parts = append(parts, value)
+			i = next
+			for i < len(body) && (body[i] == ' ' || body[i] == '\t' || body[i] == '\n' || body[i] == '\r') {
+				i++
+			}
+			if i < len(body) && body[i] == '#' {
+				i++
+				continue
+			}
+			break
+		}
+		if i < len(body) && body[i] == ',' {
+			i++
+		}
+		if name != "" {
+			fields[name] = latexText(strings.Join(parts, ""))
+		}
+	}
+	return fields, nil
+}
+
+func bibValue(body string, i int) (string, int, error) {
+	switch body[i] {
+	case '{':
+		depth := 0
+		for j := i; j < len(body); j++ {
+			switch body[j] {
+			case '{':
+				depth++
+			case '}':
+				depth--
+				if depth == 0 {
+					return body[i+1 : j], j + 1, nil
+				}
+			}
+		}
+		return "", 0, fmt.Errorf("unbalanced braces")
+	case '"':
+		depth := 0
+		for j := i + 1; j < len(body); j++ {
+			switch body[j] {
+			case '{':
+				depth++
+			case '}':
+				depth--
+			case '"':
+				if depth == 0 {
+					return body[i+1 : j], j + 1, nil
+				}
+			}
+		}
+		return "", 0, fmt.Errorf("unterminated quote")
+	default:
+		end := strings.IndexAny(body[i:], ",#")
+		if end < 0 {
+			end = len(body) - i
+		}
+		return strings.TrimSpace(body[i : i+end]), i + end, nil
+	}
+}
+
+// latexText removes grouping braces and common escapes from BibTeX values.
+func latexText(value string) string {
+	value = latexEscapeExpr.ReplaceAllString(value, "$1")
+	value = strings.NewReplacer("{", "", "}", "", "~", " ", "--", "–").Replace(value)
+	return collapseSpaces(value)
+}
+
+func bibEntry(key string, fields map[string]string) referenceEntry {
+	entry := referenceEntry{
+		Kind:     "bib",
+		Key:      key,
+		Title:    fields["title"],
+		Abstract: fields["abstract"],
+		URL:      fields["url"],
+		DOI:      findDOI(fields["doi"]),
+		Venue:    firstNonEmpty(fields["journal"], fields["booktitle"]),
+	}
+	if strings.EqualFold(fields["archiveprefix"], "arxiv") || strings.EqualFold(fields["eprinttype"], "arxiv") {
+		entry.ArXivID = fields["eprint"]
+	}
+	if fields["author"] != "" {
+		for _, author := range bibAuthorSepExpr.Split(fields["author"], -1) {
+			if name := invertName(author); name != "" {
+				entry.Authors = append(entry.Authors, name)
+			}
+		}
+	}
+	entry.Date = referenceDate(fields["date"], fields["year"], fields["month"])
+	return entry
+}
+
+// parseRIS reads TY ... ER records; only the tags that map onto articles are kept.
+func parseRIS(data string) ([]referenceEntry, error) {
+	var (
+		entries []referenceEntry
+		current *referenceEntry
+		date    string
+	)
+	scan := bufio.NewScanner(strings.NewReader(data))
+	scan.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scan.Scan() {
+		match := risLineExpr.FindStringSubmatch(strings.TrimRight(strings.TrimPrefix(scan.Text(), "\ufeff"), "\r "))
+		if match == nil {
+			continue
+		}
+		tag, value := match[1], strings.TrimSpace(match[2])
+
+		if tag == "TY" {
+			current, date = &referenceEntry{Kind: "ris"}, ""
+			continue
+		}
+		if current == nil {
+			return nil, fmt.Errorf("ris tag %s outside of a TY record", tag)
+		}
+
+		switch tag {
+		case "ER":
+			current.Date = referenceDate(date, "", "")
+			entries = append(entries, *current)
+			current = nil
+		case "TI", "T1":
+			current.Title = firstNonEmpty(current.Title, value)
+		case "AB", "N2":
+			current.Abstract = firstNonEmpty(current.Abstract, value)
+		case "AU", "A1":
+			if name := invertName(value); name != "" {
+				current.Authors = append(current.Authors, name)
+			}
+		case "DO":
+			current.DOI = firstNonEmpty(current.DOI, findDOI(value))
+		case "UR":
+			current.URL = firstNonEmpty(current.URL, value)
+		case "JO", "JF", "T2", "BT", "J2":
+			current.Venue = firstNonEmpty(current.Venue, value)
+		case "PY", "Y1", "DA":
+			date = firstNonEmpty(date, value)
+		case "ID":
+			current.Key = value
+		}
+	}
+	if err := scan.Err(); err != nil {
+		return nil, fmt.Errorf("read ris: %w", err)
+	}
+	if current != nil {
+		return nil, fmt.Errorf("ris record %q is missing ER", current.Title)
+	}
+	return entries, nil
+}
+
+type jsonReference struct {
+	ID        string            `json:"id"`
+	Title     string            `json:"title"`
+	Abstract  string            `json:"abstract"`
+	URL       string            `json:"url"`
+	DOI       string            `json:"doi"`
+	ArXivID   string            `json:"arxivId"`
+	Venue     string            `json:"venue"`
+	Authors   oneOrMany[string] `json:"authors"`
+	Published string            `json:"publishedAt"`
+	Date      string            `json:"date"`
+}
+
+// parseReferenceJSON accepts an array of references, {"items": [...]}, or a single object.
+func parseReferenceJSON(data []byte) ([]referenceEntry, error) {
+	var refs []jsonReference
+	trimmed := strings.TrimSpace(string(data))
+	switch {
+	case strings.HasPrefix(trimmed, "["):
+		if err := json.Unmarshal(data, &refs); err != nil {
+			return nil, fmt.Errorf("decode json references: %w", err)
+		}
+	default:
+		var wrapper struct {
+			Items []jsonReference `json:"items"`
+		}
+		if err := json.Unmarshal(data, &wrapper); err != nil {
+			return nil, fmt.Errorf("decode json references: %w", err)
+		}
+		refs = wrapper.Items
+		if refs == nil {
+			var single jsonReference
+			if err := json.Unmarshal(data, &single); err != nil {
+				return nil, fmt.Errorf("decode json reference: %w", err)
+			}
+			refs = []jsonReference{single}
+		}
+	}
+
+	entries := make([]referenceEntry, 0, len(refs))
+	for _, ref := range refs {
+		entries = append(entries, referenceEntry{
+			Kind:     "json",
+			Key:      strings.TrimSpace(ref.ID),
+			Title:    collapseSpaces(ref.Title),
+			Abstract: collapseSpaces(ref.Abstract),
+			URL:      strings.TrimSpace(ref.URL),
+			DOI:      findDOI(ref.DOI),
+			ArXivID:  strings.TrimSpace(ref.ArXivID),
+			Venue:    collapseSpaces(ref.Venue),
+			Authors:  ref.Authors,
+			Date:     referenceDate(firstNonEmpty(ref.Published, ref.Date), "", ""),
+		})
+	}
+	return entries, nil
+}
+
+// invertName turns "Lee, Ann" into "Ann Lee" and leaves "Ann Lee" alone.
+func invertName(name string) string {
+	name = collapseSpaces(name)
+	if last, first, ok := strings.Cut(name, ","); ok && strings.TrimSpace(first) != "" {
+		return collapseSpaces(strings.TrimSpace(first) + " " + last)
+	}
+	return name
+}
+
+// referenceDate reads ISO or RIS (2024/05/01/) dates and falls back to a bare year when that is all there is.
+func referenceDate(date, year, month string) time.Time {
+	date = strings.TrimRight(strings.ReplaceAll(strings.TrimSpace(date), "/", "-"), "-")
+	for _, layout := range []string{"2006-01-02", "2006-1-2", "2006-01"} {
+		if parsed, err := time.Parse(layout, date); err == nil {
+			return parsed
+		}
+	}
+	if parsed, ok := parseFeedDate(date, time.UTC); ok {
+		return parsed
+	}
+
+	match := yearExpr.FindString(firstNonEmpty(year, date))
+	if match == "" {
+		return time.Time{}
+	}
+	y, _ := strconv.Atoi(match)
+	m := time.January
+	month = strings.TrimSpace(month)
+	if n, err := strconv.Atoi(month); err == nil && n >= 1 && n <= 12 {
+		m = time.Month(n)
+	} else if len(month) >= 3 {
+		if parsed, err := time.Parse("Jan", strings.ToUpper(month[:1])+strings.ToLower(month[1:3])); err == nil {
+			m = parsed.Month()
+		}
+	}
+	return time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,263 @@
+package parser
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/scanner"
+)
+
+// RSSScanner reads RSS 1.0 (RDF), RSS 2.0 and Atom feeds configured as category URLs.
+type RSSScanner struct {
+	client *http.Client
+	logger *slog.Logger
+}
+
+// NewRSSScanner wires an HTTP client; nil falls back to a client with a sane timeout.
+func NewRSSScanner(client *http.Client, log *slog.Logger) *RSSScanner {
+	if client == nil {
+		client = &http.Client{Timeout: 20 * time.Second}
+	}
+	return &RSSScanner{client: client, logger: log}
+}
+
+// Name identifies the strategy inside the registry.
+func (r *RSSScanner) Name() string {
+	return "rss"
+}
+
+// Scan fetches each feed and returns items dated on the requested day in the scheduler timezone.
+func (r *RSSScanner) Scan(ctx context.Context, req scanner.Request) ([]domain.Article, error) {
+	if len(req.Categories) == 0 {
+		return nil, fmt.Errorf("no categories provided for site %s", req.SiteName)
+	}
+
+	r.debug("scan start", "site", req.SiteName, "feeds", len(req.Categories), "target_day", req.Day.Format("2006-01-02"))
+
+	results := make([]domain.Article, 0)
+	seen := map[string]struct{}{}
+	for _, cat := range req.Categories {
+		r.debug("requesting", "category", cat.Name, "url", cat.URL)
+		body, err := fetchBody(ctx, r.client, cat.URL, http.Header{
+			"Accept": {"application/rss+xml, application/rdf+xml, application/atom+xml, application/xml;q=0.9, */*;q=0.8"},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("category %s: %w", cat.Name, err)
+		}
+
+		items, err := parseFeed(body, req.Day.Location())
+		if err != nil {
+			return nil, fmt.Errorf("category %s: %w", cat.Name, err)
+		}
+
+		matched, undated := 0, 0
+		for _, item := range items {
+			if item.publishedAt.IsZero() {
+				undated++
+				continue
+			}
+			if !sameDay(item.publishedAt, req.Day) {
+				continue
+			}
+			article := item.toArticle(articleSource(req.SiteName, cat.Name))
+			if _, ok := seen[article.ID]; ok {
+				continue
+			}
+			seen[article.ID] = struct{}{}
+			results = append(results, article)
+			matched++
+		}
+		r.debug("feed processed", "category", cat.Name, "items", len(items), "articles", matched, "undated", undated)
+	}
+
+	r.debug("scan finished", "site", req.SiteName, "total", len(results))
+	return results, nil
+}
+
+// feedDocument decodes any of the three dialects: RSS 2.0 nests items in channel,
+// RSS 1.0 keeps them at the rdf:RDF root, Atom uses entry elements.
+type feedDocument struct {
+	XMLName xml.Name
+	Channel struct {
+		Items []rssItem `xml:"item"`
+	} `xml:"channel"`
+	Items   []rssItem   `xml:"item"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type rssItem struct {
+	About       string   `xml:"about,attr"`
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	Description string   `xml:"description"`
+	Encoded     string   `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	GUID        string   `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Date        string   `xml:"http://purl.org/dc/elements/1.1/ date"`
+	Identifier  []string `xml:"http://purl.org/dc/elements/1.1/ identifier"`
+	Creators    []string `xml:"http://purl.org/dc/elements/1.1/ creator"`
+	Author      string   `xml:"author"`
+	DOI         string   `xml:"http://prismstandard.org/namespaces/basic/2.0/ doi"`
+}
+
+type atomEntry struct {
+	ID        string `xml:"id"`
+	Title     string `xml:"title"`
+	Summary   string `xml:"summary"`
+	Content   string `xml:"content"`
+	Published string `xml:"published"`
+	Updated   string `xml:"updated"`
+	Links     []struct {
+		Href string `xml:"href,attr"`
+		Rel  string `xml:"rel,attr"`
+	} `xml:"link"`
+	Authors []struct {
+		Name string `xml:"name"`
+	} `xml:"author"`
+	DOI string `xml:"http://prismstandard.org/namespaces/basic/2.0/ doi"`
+}
+
+// feedItem is the dialect-neutral representation used for filtering and mapping.
+type feedItem struct {
+	id          string
+	doi         string
+	title       string
+	summary     string
+	link        string
+	authors     []string
+	publishedAt time.Time
+}
+
+func parseFeed(body []byte, loc *time.Location) ([]feedItem, error) {
+	var doc feedDocument
+	if err := xml.Unmarshal(body, &doc); err != nil {
+		return nil, fmt.Errorf("decode feed: %w", err)
+	}
+
+	var items []feedItem
+	switch doc.XMLName.Local {
+	case "rss", "RDF":
+		raw := append(doc.Channel.Items, doc.Items...)
+		for _, it := range raw {
+			items = append(items, it.normalize(loc))
+		}
+	case "feed":
+		for _, entry := range doc.Entries {
+			items = append(items, entry.normalize(loc))
+		}
+	default:
+		return nil, fmt.Errorf("unsupported feed root <%s>", doc.XMLName.Local)
+	}
+	return items, nil
+}
+
+func (it rssItem) normalize(loc *time.Location) feedItem {
+	item := feedItem{
+		title:   htmlText(it.Title),
+		summary: htmlText(it.Description),
+		link:    strings.TrimSpace(it.Link),
+	}
+	if item.summary == "" {
+		item.summary = htmlText(it.Encoded)
+	}
+	if item.link == "" {
+		item.link = strings.TrimSpace(it.About)
+	}
+
+	for _, creator := range it.Creators {
+		if name := collapseSpaces(creator); name != "" {
+			item.authors = append(item.authors, name)
+		}
+	}
+	if len(item.authors) == 0 && strings.TrimSpace(it.Author) != "" {
+		item.authors = []string{collapseSpaces(it.Author)}
+	}
+
+	item.doi = findDOI(append([]string{it.DOI}, it.Identifier...)...)
+	if item.doi == "" {
+		item.doi = findDOI(it.GUID, item.link)
+	}
+
+	item.id = strings.TrimSpace(it.GUID)
+	if item.id == "" && len(it.Identifier) > 0 {
+		item.id = strings.TrimSpace(it.Identifier[0])
+	}
+	if item.id == "" {
+		item.id = item.link
+	}
+
+	if parsed, ok := parseFeedDate(it.PubDate, loc); ok {
+		item.publishedAt = parsed
+	} else if parsed, ok := parseFeedDate(it.Date, loc); ok {
+		item.publishedAt = parsed
+	}
+	return item
+}
+
+func (e atomEntry) normalize(loc *time.Location) feedItem {
+	item := feedItem{
+		id:      strings.TrimSpace(e.ID),
+		title:   htmlText(e.Title),
+		summary: htmlText(e.Summary),
+	}
+	if item.summary == "" {
+		item.summary = htmlText(e.Content)
+	}
+	for _, link := range e.Links {
+		if link.Rel == "" || link.Rel == "alternate" {
+			item.link = strings.TrimSpace(link.Href)
+			break
+		}
+	}
+	for _, author := range e.Authors {
+		if name := collapseSpaces(author.Name); name != "" {
+			item.authors = append(item.authors, name)
+		}
+	}
+
+	item.doi = findDOI(e.DOI, e.ID, item.link)
+	if item.id == "" {
+		item.id = item.link
+	}
+
+	if parsed, ok := parseFeedDate(e.Published, loc); ok {
+		item.publishedAt = parsed
+	} else if parsed, ok := parseFeedDate(e.Updated, loc); ok {
+		item.publishedAt = parsed
+	}
+	return item
+}
+
+// toArticle prefers the DOI as identity so the same paper seen via different feeds dedups in storage.
+func (it feedItem) toArticle(source string) domain.Article {
+	id := it.id
+	if it.doi != "" {
+		id = doiArticleID(it.doi)
+	}
+	link := it.link
+	if link == "" && it.doi != "" {
+		link = "https://doi.org/" + it.doi
+	}
+	return domain.Article{
+		ID:          id,
+		Title:       it.title,
+		Abstract:    it.summary,
+		URL:         link,
+		Source:      source,
+		Authors:     it.authors,
+		DOI:         it.doi,
+		PublishedAt: it.publishedAt,
+	}
+}
+
+func (r *RSSScanner) debug(msg string, args ...interface{}) {
+	if r.logger != nil {
+		r.logger.Debug(msg, args...)
+	}
+}
@@ -0,0 +1,126 @@
+package parser
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"ArticlesScanner/internal/scanner"
+)
+
+const rss2Feed = `<?xml version="1.0"?>
+<rss version="2.0" xmlns:dc="http://purl.org/dc/elements/1.1/">
+  <channel>
+    <title>PLOS</title>
+    <item>
+      <title>Protein folding</title>
+      <link>https://journals.plos.org/article?id=10.1371/journal.pcbi.1000001</link>
+      <guid isPermaLink="false">info:doi/10.1371/journal.pcbi.1000001</guid>
+      <description>&lt;p&gt;Folding &lt;b&gt;fast&lt;/b&gt;.&lt;/p&gt;</description>
+      <pubDate>Sat, 08 Nov 2025 22:30:00 GMT</pubDate>
+      <dc:creator>Jane Roe</dc:creator>
+    </item>
+    <item>
+      <title>Stale item</title>
+      <guid>stale-1</guid>
+      <pubDate>Fri, 07 Nov 2025 10:00:00 GMT</pubDate>
+    </item>
+  </channel>
+</rss>`
+
+const rss1Feed = `<?xml version="1.0"?>
+<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#" xmlns="http://purl.org/rss/1.0/"
+  xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:prism="http://prismstandard.org/namespaces/basic/2.0/">
+  <channel rdf:about="https://www.nature.com/nature.rss"><title>Nature</title></channel>
+  <item rdf:about="https://www.nature.com/articles/s41586-025-00001-x">
+    <title>Quantum sensing</title>
+    <link>https://www.nature.com/articles/s41586-025-00001-x</link>
+    <description>Sensing abstract.</description>
+    <dc:identifier>doi:10.1038/s41586-025-00001-x</dc:identifier>
+    <dc:date>2025-11-09</dc:date>
+    <prism:doi>10.1038/S41586-025-00001-X</prism:doi>
+  </item>
+</rdf:RDF>`
+
+const atomFeed = `<?xml version="1.0"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <entry>
+    <id>tag:biorxiv.org,2025:entry-42</id>
+    <title>Cell atlas</title>
+    <summary type="html">&lt;i&gt;Atlas&lt;/i&gt; summary</summary>
+    <link rel="alternate" href="https://www.biorxiv.org/content/entry-42"/>
+    <published>2025-11-09T08:00:00+03:00</published>
+    <author><name>Ivan Petrov</name></author>
+  </entry>
+</feed>`
+
+func TestRSSScannerScan(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rss2":
+			_, _ = w.Write([]byte(rss2Feed))
+		case "/rss1":
+			_, _ = w.Write([]byte(rss1Feed))
+		case "/atom":
+			_, _ = w.Write([]byte(atomFeed))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	moscow := time.FixedZone("MSK", 3*60*60)
+	req := scanner.Request{
+		Day:      time.Date(2025, time.November, 9, 6, 0, 0, 0, moscow),
+		SiteName: "journals",
+		Categories: []scanner.Category{
+			{Name: "plos", URL: server.URL + "/rss2"},
+			{Name: "nature", URL: server.URL + "/rss1"},
+			{Name: "biorxiv", URL: server.URL + "/atom"},
+		},
+	}
+
+	articles, err := NewRSSScanner(server.Client(), nil).Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+	if len(articles) != 3 {
+		t.Fatalf("expected 3 articles, got %d: %+v", len(articles), articles)
+	}
+
+	plos := articles[0]
+	if plos.ID != "doi:10.1371/journal.pcbi.1000001" {
+		t.Fatalf("unexpected rss2 id: %s", plos.ID)
+	}
+	if plos.Abstract != "Folding fast." || plos.Source != "journals/plos" {
+		t.Fatalf("unexpected rss2 article: %+v", plos)
+	}
+	if len(plos.Authors) != 1 || plos.Authors[0] != "Jane Roe" {
+		t.Fatalf("unexpected rss2 authors: %v", plos.Authors)
+	}
+
+	nature := articles[1]
+	if nature.ID != "doi:10.1038/s41586-025-00001-x" || nature.Title != "Quantum sensing" {
+		t.Fatalf("unexpected rss1 article: %+v", nature)
+	}
+
+	atom := articles[2]
+	if atom.ID != "tag:biorxiv.org,2025:entry-42" || atom.Abstract != "Atlas summary" {
+		t.Fatalf("unexpected atom article: %+v", atom)
+	}
+	if atom.URL != "https://www.biorxiv.org/content/entry-42" {
+		t.Fatalf("unexpected atom url: %s", atom.URL)
+	}
+}
+
+func TestParseFeedRejectsUnknownRoot(t *testing.T) {
+	t.Parallel()
+
+	if _, err := parseFeed([]byte(`<html><body/></html>`), time.UTC); err == nil {
+		t.Fatal("expected error for non-feed document")
+	}
+}
@@ -0,0 +1,277 @@
+package parser
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/scanner"
+)
+
+const (
+	selectorDefaultMaxPages = 10
+	selectorDefaultPageSize = 50
+)
+
+// SelectorScanner scrapes arbitrary listing pages using CSS selectors declared in site options.
+type SelectorScanner struct {
+	client *http.Client
+	logger *slog.Logger
+}
+
+// NewSelectorScanner wires an HTTP client; nil falls back to a client with a sane timeout.
+func NewSelectorScanner(client *http.Client, log *slog.Logger) *SelectorScanner {
+	if client == nil {
+		client = &http.Client{Timeout: 20 * time.Second}
+	}
+	return &SelectorScanner{client: client, logger: log}
+}
+
+// Name identifies the strategy inside the registry.
+func (s *SelectorScanner) Name() string {
+	return "selector"
+}
+
+// selectorRules is the parsed form of the site options driving the scanner.
+type selectorRules struct {
+	item       string
+	title      string
+	abstract   string
+	link       string
+	linkAttr   string
+	id         string
+	idAttr     string
+	date       string
+	dateRegex  *regexp.Regexp
+	dateLayout string
+	pagination string
+	next       string
+	skipParam  string
+	showParam  string
+	pageSize   int
+	maxPages   int
+}
+
+// parseSelectorRules reads selector options. Required: itemSelector, titleSelector.
+// Optional: abstractSelector, linkSelector/linkAttr, idSelector/idAttr, dateSelector/dateRegex/dateLayout,
+// pagination ("next" with nextSelector, or "offset" with skipParam/showParam/pageSize) and maxPages.
+func parseSelectorRules(options map[string]string) (selectorRules, error) {
+	rules := selectorRules{
+		item:       strings.TrimSpace(options["itemSelector"]),
+		title:      strings.TrimSpace(options["titleSelector"]),
+		abstract:   strings.TrimSpace(options["abstractSelector"]),
+		link:       strings.TrimSpace(options["linkSelector"]),
+		linkAttr:   optionOr(options, "linkAttr", "href"),
+		id:         strings.TrimSpace(options["idSelector"]),
+		idAttr:     strings.TrimSpace(options["idAttr"]),
+		date:       strings.TrimSpace(options["dateSelector"]),
+		dateLayout: optionOr(options, "dateLayout", "2006-01-02"),
+		pagination: optionOr(options, "pagination", "none"),
+		next:       strings.TrimSpace(options["nextSelector"]),
+		skipParam:  optionOr(options, "skipParam", "skip"),
+		showParam:  optionOr(options, "showParam", "show"),
+		pageSize:   selectorDefaultPageSize,
+		maxPages:   selectorDefaultMaxPages,
+	}
+
+	if rules.item == "" || rules.title == "" {
+		return selectorRules{}, fmt.Errorf("itemSelector and titleSelector options are required")
+	}
+
+	if raw := strings.TrimSpace(options["dateRegex"]); raw != "" {
+		expr, err := regexp.Compile(raw)
+		if err != nil {
+			return selectorRules{}, fmt.Errorf("invalid dateRegex: %w", err)
+		}
+		rules.dateRegex = expr
+	}
+
+	for key, target := range map[string]*int{"pageSize": &rules.pageSize, "maxPages": &rules.maxPages} {
+		if raw := strings.TrimSpace(options[key]); raw != "" {
+			v, err := strconv.Atoi(raw)
+			if err != nil || v <= 0 {
+				return selectorRules{}, fmt.Errorf("invalid %s %q", key, raw)
+			}
+			*target = v
+		}
+	}
+
+	switch rules.pagination {
+	case "none", "offset":
+	case "next":
+		if rules.next == "" {
+			return selectorRules{}, fmt.Errorf("pagination next requires nextSelector")
+		}
+	default:
+		return selectorRules{}, fmt.Errorf("unsupported pagination %s", rules.pagination)
+	}
+
+	return rules, nil
+}
+
+// Scan walks every category URL using the configured selectors. Items without a date selector
+// are attributed to the requested day (e.g. proceedings pages); dated items must match it.
+func (s *SelectorScanner) Scan(ctx context.Context, req scanner.Request) ([]domain.Article, error) {
+	if len(req.Categories) == 0 {
+		return nil, fmt.Errorf("no categories provided for site %s", req.SiteName)
+	}
+
+	rules, err := parseSelectorRules(req.Options)
+	if err != nil {
+		return nil, fmt.Errorf("site %s: %w", req.SiteName, err)
+	}
+
+	s.debug("scan start", "site", req.SiteName, "categories", len(req.Categories), "target_day", req.Day.Format("2006-01-02"))
+
+	results := make([]domain.Article, 0)
+	seen := map[string]struct{}{}
+	for _, cat := range req.Categories {
+		pageURL := cat.URL
+		if rules.pagination == "offset" {
+			if pageURL, err = buildOffsetURL(cat.URL, rules.skipParam, rules.showParam, 0, rules.pageSize); err != nil {
+				return nil, fmt.Errorf("category %s: %w", cat.Name, err)
+			}
+		}
+
+		for page := 0; page < rules.maxPages && pageURL != ""; page++ {
+			s.debug("fetching", "site", req.SiteName, "category", cat.Name, "page", page, "url", pageURL)
+
+			body, err := fetchBody(ctx, s.client, pageURL, nil)
+			if err != nil {
+				return nil, fmt.Errorf("category %s: %w", cat.Name, err)
+			}
+			doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+			if err != nil {
+				return nil, fmt.Errorf("category %s: parse document: %w", cat.Name, err)
+			}
+			base, err := url.Parse(pageURL)
+			if err != nil {
+				return nil, fmt.Errorf("category %s: %w", cat.Name, err)
+			}
+
+			items, articles := rules.extract(doc, base, req.Day, articleSource(req.SiteName, cat.Name))
+			for _, article := range articles {
+				if _, ok := seen[article.ID]; ok {
+					continue
+				}
+				seen[article.ID] = struct{}{}
+				results = append(results, article)
+			}
+			s.debug("page processed", "category", cat.Name, "page", page, "items", items, "articles", len(articles))
+
+			pageURL, err = rules.nextPage(doc, base, cat.URL, page, items)
+			if err != nil {
+				return nil, fmt.Errorf("category %s: %w", cat.Name, err)
+			}
+		}
+	}
+
+	s.debug("scan finished", "site", req.SiteName, "total", len(results))
+	return results, nil
+}
+
+func (r selectorRules) extract(doc *goquery.Document, base *url.URL, day time.Time, source string) (int, []domain.Article) {
+	var articles []domain.Article
+	items := doc.Find(r.item)
+	items.Each(func(_ int, item *goquery.Selection) {
+		title := collapseSpaces(item.Find(r.title).First().Text())
+		if title == "" {
+			return
+		}
+
+		publishedAt := startOfDay(day)
+		if r.date != "" {
+			text := collapseSpaces(item.Find(r.date).First().Text())
+			if r.dateRegex != nil {
+				text = r.dateRegex.FindString(text)
+			}
+			parsed, err := time.ParseInLocation(r.dateLayout, text, day.Location())
+			if err != nil || !sameDay(parsed, day) {
+				return
+			}
+			publishedAt = parsed
+		}
+
+		link := r.resolveAttr(item, r.link, r.linkAttr, base)
+		id := link
+		if r.id != "" || r.idAttr != "" {
+			if r.idAttr != "" {
+				id = strings.TrimSpace(selectOrSelf(item, r.id).AttrOr(r.idAttr, ""))
+			} else {
+				id = collapseSpaces(item.Find(r.id).First().Text())
+			}
+		}
+		if id == "" {
+			id = source + "/" + title
+		}
+
+		var abstract string
+		if r.abstract != "" {
+			abstract = collapseSpaces(item.Find(r.abstract).First().Text())
+		}
+
+		articles = append(articles, domain.Article{
+			ID:          id,
+			Title:       title,
+			Abstract:    abstract,
+			URL:         link,
+			Source:      source,
+			PublishedAt: publishedAt,
+		})
+	})
+	return items.Length(), articles
+}
+
+// resolveAttr reads an attribute from the selected node (or the item itself) as an absolute URL.
+func (r selectorRules) resolveAttr(item *goquery.Selection, selector, attr string, base *url.URL) string {
+	node := selectOrSelf(item, selector)
+	if selector == "" && node.AttrOr(attr, "") == "" {
+		node = item.Find("a[href]").First()
+	}
+	raw := strings.TrimSpace(node.AttrOr(attr, ""))
+	if raw == "" {
+		return ""
+	}
+	ref, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+	return base.ResolveReference(ref).String()
+}
+
+func (r selectorRules) nextPage(doc *goquery.Document, base *url.URL, categoryURL string, page, items int) (string, error) {
+	switch r.pagination {
+	case "next":
+		return r.resolveAttr(doc.Selection, r.next, "href", base), nil
+	case "offset":
+		if items < r.pageSize {
+			return "", nil
+		}
+		return buildOffsetURL(categoryURL, r.skipParam, r.showParam, (page+1)*r.pageSize, r.pageSize)
+	default:
+		return "", nil
+	}
+}
+
+func selectOrSelf(item *goquery.Selection, selector string) *goquery.Selection {
+	if selector == "" {
+		return item
+	}
+	return item.Find(selector).First()
+}
+
+func (s *SelectorScanner) debug(msg string, args ...interface{}) {
+	if s.logger != nil {
+		s.logger.Debug(msg, args...)
+	}
+}
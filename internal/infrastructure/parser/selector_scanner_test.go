@@ -0,0 +1,141 @@
+package parser
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"ArticlesScanner/internal/scanner"
+)
+
+func TestParseSelectorRulesValidation(t *testing.T) {
+	t.Parallel()
+
+	if _, err := parseSelectorRules(map[string]string{"itemSelector": "li"}); err == nil {
+		t.Fatal("expected error without titleSelector")
+	}
+	if _, err := parseSelectorRules(map[string]string{"itemSelector": "li", "titleSelector": "a", "pagination": "next"}); err == nil {
+		t.Fatal("expected error for next pagination without nextSelector")
+	}
+	if _, err := parseSelectorRules(map[string]string{"itemSelector": "li", "titleSelector": "a", "pageSize": "zero"}); err == nil {
+		t.Fatal("expected error for invalid pageSize")
+	}
+}
+
+func TestSelectorScannerNextPagination(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/proceedings":
+			_, _ = w.Write([]byte(`
+			<ul>
+			  <li class="paper" data-id="p1">
+			    <a class="title" href="/papers/p1">Graph Transformers</a>
+			    <span class="date">Published 08.11.2025</span>
+			    <p class="abstract">  Attention on graphs. </p>
+			  </li>
+			  <li class="paper" data-id="p0">
+			    <a class="title" href="/papers/p0">Old Paper</a>
+			    <span class="date">Published 01.11.2025</span>
+			  </li>
+			</ul>
+			<a class="next" href="/proceedings/page/2">Next</a>`))
+		case "/proceedings/page/2":
+			_, _ = w.Write([]byte(`
+			<ul>
+			  <li class="paper" data-id="p2">
+			    <a class="title" href="https://cdn.example.org/p2">Sparse Models</a>
+			    <span class="date">Published 08.11.2025</span>
+			  </li>
+			</ul>`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	req := scanner.Request{
+		Day:      time.Date(2025, time.November, 8, 6, 0, 0, 0, time.UTC),
+		SiteName: "conf",
+		Options: map[string]string{
+			"itemSelector":     "li.paper",
+			"titleSelector":    "a.title",
+			"linkSelector":     "a.title",
+			"abstractSelector": ".abstract",
+			"idAttr":           "data-id",
+			"dateSelector":     ".date",
+			"dateRegex":        `\d{2}\.\d{2}\.\d{4}`,
+			"dateLayout":       "02.01.2006",
+			"pagination":       "next",
+			"nextSelector":     "a.next",
+		},
+		Categories: []scanner.Category{{Name: "main", URL: server.URL + "/proceedings"}},
+	}
+
+	articles, err := NewSelectorScanner(server.Client(), nil).Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+	if len(articles) != 2 {
+		t.Fatalf("expected 2 articles, got %d: %+v", len(articles), articles)
+	}
+
+	if articles[0].ID != "p1" || articles[0].Abstract != "Attention on graphs." {
+		t.Fatalf("unexpected first article: %+v", articles[0])
+	}
+	if articles[0].URL != server.URL+"/papers/p1" {
+		t.Fatalf("expected resolved link, got %s", articles[0].URL)
+	}
+	if articles[1].URL != "https://cdn.example.org/p2" || articles[1].Source != "conf/main" {
+		t.Fatalf("unexpected second article: %+v", articles[1])
+	}
+}
+
+func TestSelectorScannerOffsetPagination(t *testing.T) {
+	t.Parallel()
+
+	var skips []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		skips = append(skips, r.URL.Query().Get("offset"))
+		if r.URL.Query().Get("limit") != "2" {
+			t.Errorf("unexpected limit: %s", r.URL.RawQuery)
+		}
+		if r.URL.Query().Get("offset") == "0" {
+			_, _ = w.Write([]byte(`<div class="row"><h3><a href="a">A</a></h3></div><div class="row"><h3><a href="b">B</a></h3></div>`))
+			return
+		}
+		_, _ = w.Write([]byte(`<div class="row"><h3><a href="c">C</a></h3></div>`))
+	}))
+	defer server.Close()
+
+	req := scanner.Request{
+		Day:      time.Date(2025, time.November, 8, 0, 0, 0, 0, time.UTC),
+		SiteName: "workshop",
+		Options: map[string]string{
+			"itemSelector":  "div.row",
+			"titleSelector": "h3",
+			"pagination":    "offset",
+			"skipParam":     "offset",
+			"showParam":     "limit",
+			"pageSize":      "2",
+		},
+		Categories: []scanner.Category{{Name: "accepted", URL: server.URL + "/list/"}},
+	}
+
+	articles, err := NewSelectorScanner(server.Client(), nil).Scan(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+	if len(skips) != 2 || skips[1] != "2" {
+		t.Fatalf("unexpected offsets requested: %v", skips)
+	}
+	if len(articles) != 3 {
+		t.Fatalf("expected 3 articles, got %d", len(articles))
+	}
+	if articles[2].ID != server.URL+"/list/c" {
+		t.Fatalf("expected link-based id, got %s", articles[2].ID)
+	}
+}
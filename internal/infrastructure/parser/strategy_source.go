@@ -2,8 +2,11 @@ package parser
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
+	"sync"
 	"time"
 
 	"ArticlesScanner/internal/config"
@@ -14,61 +17,251 @@ import (
 
 // StrategySource implements ArticleSource via registered scanner strategies.
 type StrategySource struct {
-	registry *scanner.Registry
-	sites    []config.SiteConfig
-	logger   *slog.Logger
+	registry        *scanner.Registry
+	sites           []config.SiteConfig
+	siteWorkers     int
+	categoryWorkers int
+	maxFailureRatio float64
+	outcomes        ports.ScanOutcomeStore
+	logger          *slog.Logger
+
+	mu      sync.Mutex
+	pending map[string][]domain.ScanOutcome
 }
 
 var _ ports.ArticleSource = (*StrategySource)(nil)
+var _ ports.ScanCommitter = (*StrategySource)(nil)
 
-// NewStrategySource wires scanner registry with config-defined sites.
-func NewStrategySource(reg *scanner.Registry, sites []config.SiteConfig, log *slog.Logger) *StrategySource {
+// NewStrategySource wires scanner registry with config-defined sites, worker limits and the failure
+// threshold; outcomes may be nil, in which case every run scans every site.
+func NewStrategySource(reg *scanner.Registry, sites []config.SiteConfig, scan config.ScanConfig, outcomes ports.ScanOutcomeStore, log *slog.Logger) *StrategySource {
 	return &StrategySource{
-		registry: reg,
-		sites:    sites,
-		logger:   log,
+		registry:        reg,
+		sites:           sites,
+		siteWorkers:     scan.SiteWorkers,
+		categoryWorkers: scan.CategoryWorkers,
+		maxFailureRatio: scan.FailureRatio(),
+		outcomes:        outcomes,
+		logger:          log,
+		pending:         map[string][]domain.ScanOutcome{},
 	}
 }
 
-// FetchDaily iterates over configured sites and executes their scanners.
+// FetchDaily runs the planned sites on a bounded worker pool and merges their articles in config
+// order; an article announced by several sites (cross-listed categories) is kept once, from the first site.
+//
+// A failing site or category is recorded and the others still deliver; the run fails only when the
+// share of failed sites exceeds the configured ratio, and then only the failures are stored. The
+// outcomes of an accepted run are staged until CommitScan; once stored, a re-run of the same day
+// scans only the sources that failed before.
 func (s *StrategySource) FetchDaily(ctx context.Context, day time.Time) ([]domain.Article, error) {
 	if s.registry == nil {
 		return nil, fmt.Errorf("scanner registry is not configured")
 	}
 
-	s.debug("fetch daily", "sites", len(s.sites), "day", day.Format("2006-01-02"))
+	plan := s.plan(ctx, day)
+	s.debug("fetch daily", "sites", len(plan), "configured", len(s.sites), "day", day.Format("2006-01-02"), "workers", s.siteWorkers)
+	if len(plan) == 0 {
+		s.info("all sources already scanned for the day", "day", day.Format("2006-01-02"))
+		return nil, nil
+	}
+
+	perSite := make([][]domain.Article, len(plan))
+	perSiteOutcomes := make([][]domain.ScanOutcome, len(plan))
+	runLimited(ctx, s.siteWorkers, len(plan), func(ctx context.Context, i int) error {
+		perSite[i], perSiteOutcomes[i] = s.scanSite(ctx, plan[i], day)
+		return nil
+	})
+
+	report := domain.ScanReport{Day: day}
+	for _, outcomes := range perSiteOutcomes {
+		report.Outcomes = append(report.Outcomes, outcomes...)
+	}
+	s.logReport(report, len(plan))
+
+	// A rejected run delivers nothing, so only its failures are stored: recording the successful
+	// sites would make the same-day retry skip them and lose their articles.
+	if failed := report.FailedSites(); failed > 0 && float64(failed)/float64(len(plan)) > s.maxFailureRatio {
+		s.saveOutcomes(ctx, report.Failures())
+		return nil, fmt.Errorf("%d of %d sites failed: %s", failed, len(plan), describeFailures(report))
+	}
+	s.mu.Lock()
+	s.pending[day.Format("2006-01-02")] = report.Outcomes
+	s.mu.Unlock()
 
 	var aggregated []domain.Article
-	for _, site := range s.sites {
-		s.debug("process site", "site", site.Name, "scanner", site.Scanner, "categories", len(site.Categories))
-		strategy, err := s.registry.Resolve(site.Scanner)
-		if err != nil {
-			return nil, fmt.Errorf("site %s: %w", site.Name, err)
+	seen := map[string]string{}
+	for i, results := range perSite {
+		for _, article := range results {
+			if first, ok := seen[article.ID]; ok {
+				s.debug("skip duplicate article", "article_id", article.ID, "site", plan[i].Name, "first_site", first)
+				continue
+			}
+			seen[article.ID] = plan[i].Name
+			aggregated = append(aggregated, article)
 		}
+	}
 
-		req := scanner.Request{
-			Day:        day,
-			SiteName:   site.Name,
-			Options:    site.Options,
-			Categories: toScannerCategories(site.Categories),
-		}
+	s.debug("strategy source done", "total_articles", len(aggregated))
+	return aggregated, nil
+}
+
+// plan drops sources that already succeeded for the day: sites whose site-level outcome failed are
+// rescanned in full, otherwise only their failed categories are; unknown sites are always scanned.
+func (s *StrategySource) plan(ctx context.Context, day time.Time) []config.SiteConfig {
+	if s.outcomes == nil {
+		return s.sites
+	}
+	plan := make([]config.SiteConfig, 0, len(s.sites))
 
-		results, err := strategy.Scan(ctx, req)
-		if err != nil {
-			return nil, fmt.Errorf("scan site %s: %w", site.Name, err)
+	previous, err := s.outcomes.LoadScanOutcomes(ctx, day)
+	if err != nil {
+		s.warn("load scan outcomes failed, scanning every site", "error", err)
+		previous = nil
+	}
+	siteOutcome := map[string]domain.ScanOutcome{}
+	failedCategories := map[string]map[string]bool{}
+	for _, outcome := range previous {
+		if outcome.Category == "" {
+			siteOutcome[outcome.Site] = outcome
+			continue
 		}
+		if outcome.Failed() {
+			if failedCategories[outcome.Site] == nil {
+				failedCategories[outcome.Site] = map[string]bool{}
+			}
+			failedCategories[outcome.Site][outcome.Category] = true
+		}
+	}
 
-		for i := range results {
-			if results[i].Source == "" {
-				results[i].Source = site.Name
+	for _, site := range s.sites {
+		outcome, scanned := siteOutcome[site.Name]
+		switch {
+		case !scanned || outcome.Failed():
+			plan = append(plan, site)
+		case len(failedCategories[site.Name]) > 0:
+			retry := site
+			retry.Categories = nil
+			for _, cat := range site.Categories {
+				if failedCategories[site.Name][cat.Name] {
+					retry.Categories = append(retry.Categories, cat)
+				}
 			}
+			if len(retry.Categories) == 0 {
+				continue
+			}
+			s.info("retrying failed categories", "site", site.Name, "categories", len(retry.Categories))
+			plan = append(plan, retry)
+		default:
+			s.debug("skip site (already scanned today)", "site", site.Name)
 		}
-		s.debug("site produced articles", "site", site.Name, "count", len(results))
-		aggregated = append(aggregated, results...)
 	}
+	return plan
+}
 
-	s.debug("strategy source done", "total_articles", len(aggregated))
-	return aggregated, nil
+// scanSite never fails the run itself: errors become outcomes with their cause. Besides the
+// site-level outcome every scanned category gets one, so a later retry can target failed categories.
+func (s *StrategySource) scanSite(ctx context.Context, site config.SiteConfig, day time.Time) ([]domain.Article, []domain.ScanOutcome) {
+	s.debug("process site", "site", site.Name, "scanner", site.Scanner, "categories", len(site.Categories))
+
+	outcome := domain.ScanOutcome{Day: day, Site: site.Name}
+	strategy, err := s.registry.Resolve(site.Scanner)
+	if err != nil {
+		outcome.Error = err.Error()
+		outcome.ScannedAt = time.Now()
+		return nil, []domain.ScanOutcome{outcome}
+	}
+
+	req := scanner.Request{
+		Day:        day,
+		SiteName:   site.Name,
+		Options:    site.Options,
+		Categories: toScannerCategories(site.Categories),
+		Workers:    s.categoryWorkers,
+	}
+
+	results, err := strategy.Scan(ctx, req)
+	outcome.ScannedAt = time.Now()
+
+	var partial *scanner.PartialError
+	if err != nil && !errors.As(err, &partial) {
+		outcome.Error = fmt.Sprintf("scan site %s: %v", site.Name, err)
+		return nil, []domain.ScanOutcome{outcome}
+	}
+
+	for i := range results {
+		if results[i].Source == "" {
+			results[i].Source = site.Name
+		}
+	}
+	outcome.Articles = len(results)
+	s.debug("site produced articles", "site", site.Name, "count", len(results))
+
+	outcomes := []domain.ScanOutcome{outcome}
+	failed := map[string]error{}
+	if partial != nil {
+		for _, failure := range partial.Failures {
+			failed[failure.Category] = failure.Err
+		}
+	}
+	for _, cat := range site.Categories {
+		categoryOutcome := domain.ScanOutcome{Day: day, Site: site.Name, Category: cat.Name, ScannedAt: outcome.ScannedAt}
+		if cause, ok := failed[cat.Name]; ok {
+			categoryOutcome.Error = cause.Error()
+		}
+		outcomes = append(outcomes, categoryOutcome)
+	}
+	return results, outcomes
+}
+
+// CommitScan stores the outcomes staged by the day's last accepted FetchDaily, marking its
+// successful sources as scanned. Call it only after the fetched articles are stored.
+func (s *StrategySource) CommitScan(ctx context.Context, day time.Time) error {
+	key := day.Format("2006-01-02")
+	s.mu.Lock()
+	outcomes := s.pending[key]
+	delete(s.pending, key)
+	s.mu.Unlock()
+
+	if s.outcomes == nil || len(outcomes) == 0 {
+		return nil
+	}
+	if err := s.outcomes.SaveScanOutcomes(ctx, outcomes); err != nil {
+		return fmt.Errorf("persist scan outcomes: %w", err)
+	}
+	return nil
+}
+
+func (s *StrategySource) saveOutcomes(ctx context.Context, outcomes []domain.ScanOutcome) {
+	if s.outcomes == nil || len(outcomes) == 0 {
+		return
+	}
+	if err := s.outcomes.SaveScanOutcomes(ctx, outcomes); err != nil {
+		s.warn("persist scan outcomes failed", "error", err)
+	}
+}
+
+func (s *StrategySource) logReport(report domain.ScanReport, sites int) {
+	failures := report.Failures()
+	if len(failures) == 0 {
+		s.info("scan report", "day", report.Day.Format("2006-01-02"), "sites", sites, "failed", 0)
+		return
+	}
+	for _, failure := range failures {
+		s.warn("source failed", "site", failure.Site, "category", failure.Category, "error", failure.Error)
+	}
+	s.info("scan report", "day", report.Day.Format("2006-01-02"), "sites", sites,
+		"failed_sites", report.FailedSites(), "failed_sources", len(failures))
+}
+
+func describeFailures(report domain.ScanReport) string {
+	parts := make([]string, 0)
+	for _, failure := range report.Failures() {
+		if failure.Category == "" {
+			parts = append(parts, failure.Error)
+		}
+	}
+	return strings.Join(parts, "; ")
 }
 
 func toScannerCategories(cfg []config.CategoryConfig) []scanner.Category {
@@ -87,3 +280,15 @@ func (s *StrategySource) debug(msg string, args ...interface{}) {
 		s.logger.Debug(msg, args...)
 	}
 }
+
+func (s *StrategySource) info(msg string, args ...interface{}) {
+	if s.logger != nil {
+		s.logger.Info(msg, args...)
+	}
+}
+
+func (s *StrategySource) warn(msg string, args ...interface{}) {
+	if s.logger != nil {
+		s.logger.Warn(msg, args...)
+	}
+}
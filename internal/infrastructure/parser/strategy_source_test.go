@@ -0,0 +1,311 @@
+package parser
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"ArticlesScanner/internal/config"
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/scanner"
+)
+
+// stubScanner returns canned articles per site after a delay that reverses completion order.
+type stubScanner struct {
+	articles map[string][]domain.Article
+	failures map[string]error
+	delays   map[string]time.Duration
+	active   atomic.Int32
+	peak     atomic.Int32
+}
+
+func (s *stubScanner) Name() string { return "stub" }
+
+func (s *stubScanner) Scan(ctx context.Context, req scanner.Request) ([]domain.Article, error) {
+	current := s.active.Add(1)
+	defer s.active.Add(-1)
+	for {
+		peak := s.peak.Load()
+		if current <= peak || s.peak.CompareAndSwap(peak, current) {
+			break
+		}
+	}
+
+	select {
+	case <-time.After(s.delays[req.SiteName]):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if err := s.failures[req.SiteName]; err != nil {
+		return nil, err
+	}
+	return s.articles[req.SiteName], nil
+}
+
+func TestStrategySourceOrdersAndDeduplicates(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubScanner{
+		articles: map[string][]domain.Article{
+			"arxiv-ai": {{ID: "2511.00001"}, {ID: "2511.00002"}},
+			"arxiv-lg": {{ID: "2511.00002"}, {ID: "2511.00003"}},
+			"journals": {{ID: "doi:10.1/x"}},
+		},
+		delays: map[string]time.Duration{"arxiv-ai": 30 * time.Millisecond, "arxiv-lg": 15 * time.Millisecond},
+	}
+	registry := scanner.NewRegistry()
+	registry.Register(stub)
+
+	sites := []config.SiteConfig{
+		{Name: "arxiv-ai", Scanner: "stub"},
+		{Name: "arxiv-lg", Scanner: "stub"},
+		{Name: "journals", Scanner: "stub"},
+	}
+	source := NewStrategySource(registry, sites, config.ScanConfig{SiteWorkers: 3}, nil, nil)
+
+	articles, err := source.FetchDaily(context.Background(), time.Now())
+	if err != nil {
+		t.Fatalf("FetchDaily error: %v", err)
+	}
+
+	var ids []string
+	for _, article := range articles {
+		ids = append(ids, article.ID+"@"+article.Source)
+	}
+	want := "2511.00001@arxiv-ai,2511.00002@arxiv-ai,2511.00003@arxiv-lg,doi:10.1/x@journals"
+	if got := strings.Join(ids, ","); got != want {
+		t.Fatalf("unexpected articles:\n got %s\nwant %s", got, want)
+	}
+	if stub.peak.Load() < 2 {
+		t.Fatalf("expected sites to be scanned concurrently, peak %d", stub.peak.Load())
+	}
+}
+
+// memoryOutcomes is an in-test ScanOutcomeStore keyed by site and category.
+type memoryOutcomes struct {
+	mu       sync.Mutex
+	outcomes map[string]domain.ScanOutcome
+}
+
+func (m *memoryOutcomes) SaveScanOutcomes(_ context.Context, outcomes []domain.ScanOutcome) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.outcomes == nil {
+		m.outcomes = map[string]domain.ScanOutcome{}
+	}
+	for _, outcome := range outcomes {
+		m.outcomes[outcome.Site+"/"+outcome.Category] = outcome
+	}
+	return nil
+}
+
+func (m *memoryOutcomes) LoadScanOutcomes(_ context.Context, _ time.Time) ([]domain.ScanOutcome, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var outcomes []domain.ScanOutcome
+	for _, outcome := range m.outcomes {
+		outcomes = append(outcomes, outcome)
+	}
+	return outcomes, nil
+}
+
+// categoryScanner fails the categories listed in failing and records what it was asked to scan.
+type categoryScanner struct {
+	mu      sync.Mutex
+	failing map[string]bool
+	scanned []string
+}
+
+func (c *categoryScanner) Name() string { return "categories" }
+
+func (c *categoryScanner) Scan(_ context.Context, req scanner.Request) ([]domain.Article, error) {
+	var (
+		articles []domain.Article
+		partial  scanner.PartialError
+	)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, cat := range req.Categories {
+		c.scanned = append(c.scanned, req.SiteName+"/"+cat.Name)
+		if c.failing[cat.Name] {
+			partial.Failures = append(partial.Failures, scanner.CategoryFailure{Category: cat.Name, Err: errors.New("503")})
+			continue
+		}
+		articles = append(articles, domain.Article{ID: cat.Name + "-paper"})
+	}
+	if len(partial.Failures) > 0 {
+		return articles, &partial
+	}
+	return articles, nil
+}
+
+func ratio(v float64) *float64 { return &v }
+
+func TestStrategySourceToleratesFailures(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubScanner{
+		articles: map[string][]domain.Article{"ok": {{ID: "a"}}},
+		failures: map[string]error{"broken": errors.New("boom")},
+	}
+	cats := &categoryScanner{failing: map[string]bool{"cs.LG": true}}
+	registry := scanner.NewRegistry()
+	registry.Register(stub)
+	registry.Register(cats)
+
+	sites := []config.SiteConfig{
+		{Name: "ok", Scanner: "stub"},
+		{Name: "broken", Scanner: "stub"},
+		{Name: "arxiv", Scanner: "categories", Categories: []config.CategoryConfig{{Name: "cs.AI"}, {Name: "cs.LG"}}},
+	}
+	store := &memoryOutcomes{}
+	source := NewStrategySource(registry, sites, config.ScanConfig{SiteWorkers: 2, MaxFailureRatio: ratio(0.5)}, store, nil)
+
+	day := time.Date(2025, time.November, 11, 6, 0, 0, 0, time.UTC)
+	articles, err := source.FetchDaily(context.Background(), day)
+	if err != nil {
+		t.Fatalf("FetchDaily error: %v", err)
+	}
+	if len(articles) != 2 || articles[0].ID != "a" || articles[1].ID != "cs.AI-paper" {
+		t.Fatalf("unexpected articles: %+v", articles)
+	}
+	if len(store.outcomes) != 0 {
+		t.Fatalf("outcomes must wait for CommitScan, got %+v", store.outcomes)
+	}
+	if err := source.CommitScan(context.Background(), day); err != nil {
+		t.Fatalf("CommitScan error: %v", err)
+	}
+	if outcome := store.outcomes["broken/"]; !strings.Contains(outcome.Error, "boom") {
+		t.Fatalf("expected site failure with cause, got %+v", outcome)
+	}
+	if outcome := store.outcomes["arxiv/cs.LG"]; !outcome.Failed() {
+		t.Fatalf("expected category failure, got %+v", outcome)
+	}
+	if outcome := store.outcomes["arxiv/"]; outcome.Failed() || outcome.Articles != 1 {
+		t.Fatalf("partially failed site should still succeed, got %+v", outcome)
+	}
+
+	// The re-run retries the broken site and the failed category only.
+	delete(stub.failures, "broken")
+	cats.failing = nil
+	cats.scanned = nil
+	if _, err := source.FetchDaily(context.Background(), day); err != nil {
+		t.Fatalf("retry FetchDaily error: %v", err)
+	}
+	if err := source.CommitScan(context.Background(), day); err != nil {
+		t.Fatalf("CommitScan error: %v", err)
+	}
+	if got := strings.Join(cats.scanned, ","); got != "arxiv/cs.LG" {
+		t.Fatalf("expected only the failed category to be retried, got %s", got)
+	}
+	for key, outcome := range store.outcomes {
+		if outcome.Failed() {
+			t.Fatalf("outcome %s still failed after retry: %+v", key, outcome)
+		}
+	}
+
+	articles, err = source.FetchDaily(context.Background(), day)
+	if err != nil || len(articles) != 0 {
+		t.Fatalf("expected nothing left to scan, got %d articles, err %v", len(articles), err)
+	}
+}
+
+func TestStrategySourceFailsAboveThreshold(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubScanner{
+		articles: map[string][]domain.Article{"third": {{ID: "a"}}},
+		failures: map[string]error{"first": errors.New("timeout"), "second": errors.New("boom")},
+	}
+	registry := scanner.NewRegistry()
+	registry.Register(stub)
+
+	sites := []config.SiteConfig{{Name: "first", Scanner: "stub"}, {Name: "second", Scanner: "stub"}, {Name: "third", Scanner: "stub"}}
+	source := NewStrategySource(registry, sites, config.ScanConfig{SiteWorkers: 3, MaxFailureRatio: ratio(0.5)}, nil, nil)
+
+	_, err := source.FetchDaily(context.Background(), time.Now())
+	if err == nil || !strings.Contains(err.Error(), "2 of 3 sites failed: scan site first: timeout; scan site second: boom") {
+		t.Fatalf("expected threshold failure listing sites in order, got %v", err)
+	}
+
+	// A ratio of 0 is honoured, not treated as unset: a single failed site fails the run.
+	delete(stub.failures, "second")
+	strict := NewStrategySource(registry, sites, config.ScanConfig{SiteWorkers: 3, MaxFailureRatio: ratio(0)}, nil, nil)
+	if _, err := strict.FetchDaily(context.Background(), time.Now()); err == nil || !strings.Contains(err.Error(), "1 of 3 sites failed") {
+		t.Fatalf("expected a zero ratio to fail on any site, got %v", err)
+	}
+}
+
+func TestStrategySourceRescansSucceededSitesAfterRejectedRun(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubScanner{
+		articles: map[string][]domain.Article{"third": {{ID: "a"}}},
+		failures: map[string]error{"first": errors.New("timeout"), "second": errors.New("boom")},
+	}
+	registry := scanner.NewRegistry()
+	registry.Register(stub)
+
+	sites := []config.SiteConfig{{Name: "first", Scanner: "stub"}, {Name: "second", Scanner: "stub"}, {Name: "third", Scanner: "stub"}}
+	store := &memoryOutcomes{}
+	source := NewStrategySource(registry, sites, config.ScanConfig{SiteWorkers: 3, MaxFailureRatio: ratio(0.5)}, store, nil)
+
+	day := time.Date(2025, time.November, 11, 6, 0, 0, 0, time.UTC)
+	if _, err := source.FetchDaily(context.Background(), day); err == nil {
+		t.Fatal("expected threshold failure")
+	}
+	if _, ok := store.outcomes["third/"]; ok {
+		t.Fatalf("a rejected run must not record its successful sites, got %+v", store.outcomes)
+	}
+	if outcome := store.outcomes["first/"]; !outcome.Failed() {
+		t.Fatalf("expected the failure to be recorded, got %+v", outcome)
+	}
+
+	delete(stub.failures, "first")
+	articles, err := source.FetchDaily(context.Background(), day)
+	if err != nil {
+		t.Fatalf("retry FetchDaily error: %v", err)
+	}
+	if len(articles) != 1 || articles[0].ID != "a" || articles[0].Source != "third" {
+		t.Fatalf("expected the retry to scan the successful site again, got %+v", articles)
+	}
+	if err := source.CommitScan(context.Background(), day); err != nil {
+		t.Fatalf("CommitScan error: %v", err)
+	}
+	if outcome, ok := store.outcomes["third/"]; !ok || outcome.Failed() || outcome.Articles != 1 {
+		t.Fatalf("expected the accepted retry to record the site, got %+v", outcome)
+	}
+}
+
+func TestStrategySourceRescansUntilCommitted(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubScanner{articles: map[string][]domain.Article{"site": {{ID: "a1"}}}}
+	registry := scanner.NewRegistry()
+	registry.Register(stub)
+
+	store := &memoryOutcomes{}
+	source := NewStrategySource(registry, []config.SiteConfig{{Name: "site", Scanner: "stub"}}, config.ScanConfig{}, store, nil)
+	day := time.Date(2025, time.November, 11, 6, 0, 0, 0, time.UTC)
+
+	// The caller failed before storing the articles, so nothing was committed and the retry rescans.
+	for attempt := 1; attempt <= 2; attempt++ {
+		articles, err := source.FetchDaily(context.Background(), day)
+		if err != nil || len(articles) != 1 {
+			t.Fatalf("attempt %d: got %+v, err %v", attempt, articles, err)
+		}
+	}
+	if err := source.CommitScan(context.Background(), day); err != nil {
+		t.Fatalf("CommitScan error: %v", err)
+	}
+	if outcome, ok := store.outcomes["site/"]; !ok || outcome.Articles != 1 {
+		t.Fatalf("expected the committed outcome, got %+v", store.outcomes)
+	}
+	if articles, err := source.FetchDaily(context.Background(), day); err != nil || len(articles) != 0 {
+		t.Fatalf("expected committed site to be skipped, got %+v, err %v", articles, err)
+	}
+}
@@ -0,0 +1,197 @@
+package memory
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"ArticlesScanner/internal/domain"
+)
+
+// record is one line of the journal: the latest snapshot of a single entity.
+type record struct {
+	Processed  *domain.ProcessedArticle `json:"processed,omitempty"`
+	Version    *versionRecord           `json:"version,omitempty"`
+	Enrichment *enrichmentRecord        `json:"enrichment,omitempty"`
+	Outcome    *domain.ScanOutcome      `json:"outcome,omitempty"`
+	Article    *domain.Article          `json:"article,omitempty"`
+	Review     *domain.ArticleReview    `json:"review,omitempty"`
+	Run        *runRecord               `json:"run,omitempty"`
+	Checkpoint *domain.Checkpoint       `json:"checkpoint,omitempty"`
+}
+
+type versionRecord struct {
+	ArticleID string `json:"articleId"`
+	Version   int    `json:"version"`
+}
+
+type enrichmentRecord struct {
+	ArticleID  string            `json:"articleId"`
+	Enrichment domain.Enrichment `json:"enrichment"`
+}
+
+// runRecord keeps the run day's location name, which JSON timestamps reduce to an offset.
+type runRecord struct {
+	Run      domain.PipelineRun `json:"run"`
+	Timezone string             `json:"timezone"`
+}
+
+func newRunRecord(run domain.PipelineRun) record {
+	return record{Run: &runRecord{Run: run, Timezone: run.Day.Location().String()}}
+}
+
+// journal appends records to a JSON-lines file.
+type journal struct {
+	file *os.File
+}
+
+func (j *journal) append(records []record) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, rec := range records {
+		if err := encoder.Encode(rec); err != nil {
+			return fmt.Errorf("encode journal record: %w", err)
+		}
+	}
+	if _, err := j.file.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("write journal: %w", err)
+	}
+	return nil
+}
+
+// Open loads the JSON-lines journal at path, creating it when missing, and returns a repository
+// that appends every change to it. The journal is compacted to one line per entity on open.
+func Open(path string) (*Repository, error) {
+	repo := New()
+
+	if err := repo.replay(path); err != nil {
+		return nil, err
+	}
+	if err := repo.compact(path); err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open journal: %w", err)
+	}
+	repo.journal = &journal{file: file}
+	return repo, nil
+}
+
+// Close flushes and closes the journal; it is a no-op for purely in-memory repositories.
+func (r *Repository) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.journal == nil {
+		return nil
+	}
+	file := r.journal.file
+	r.journal = nil
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("sync journal: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("close journal: %w", err)
+	}
+	return nil
+}
+
+// replay applies every record in the journal; a torn final line left by a crash is ignored.
+func (r *Repository) replay(path string) error {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("open journal: %w", err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	for line := 1; ; line++ {
+		data, readErr := reader.ReadBytes('\n')
+		if readErr != nil && !errors.Is(readErr, io.EOF) {
+			return fmt.Errorf("read journal: %w", readErr)
+		}
+		if len(bytes.TrimSpace(data)) > 0 {
+			var rec record
+			if err := json.Unmarshal(data, &rec); err != nil {
+				if errors.Is(readErr, io.EOF) {
+					return nil
+				}
+				return fmt.Errorf("decode journal line %d: %w", line, err)
+			}
+			r.apply(rec)
+		}
+		if errors.Is(readErr, io.EOF) {
+			return nil
+		}
+	}
+}
+
+// compact rewrites the journal with the current snapshot of every entity.
+func (r *Repository) compact(path string) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("create journal snapshot: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := (&journal{file: tmp}).append(r.snapshot()); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("sync journal snapshot: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close journal snapshot: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("replace journal: %w", err)
+	}
+	return nil
+}
+
+// snapshot returns one record per stored entity, parents before the entities that refer to them.
+func (r *Repository) snapshot() []record {
+	var records []record
+	for _, article := range r.processed {
+		records = append(records, record{Processed: &article})
+	}
+	for id, versions := range r.versions {
+		for version := range versions {
+			records = append(records, record{Version: &versionRecord{ArticleID: id, Version: version}})
+		}
+	}
+	for id, enrichment := range r.enrichments {
+		records = append(records, record{Enrichment: &enrichmentRecord{ArticleID: id, Enrichment: enrichment}})
+	}
+	for _, outcome := range r.outcomes {
+		records = append(records, record{Outcome: &outcome})
+	}
+	for _, article := range r.articles {
+		records = append(records, record{Article: &article})
+	}
+	for _, review := range r.reviews {
+		records = append(records, record{Review: &review})
+	}
+	for _, run := range r.runs {
+		records = append(records, newRunRecord(run))
+	}
+	for _, checkpoints := range r.checkpoints {
+		for _, checkpoint := range checkpoints {
+			records = append(records, record{Checkpoint: &checkpoint})
+		}
+	}
+	return records
+}
@@ -0,0 +1,357 @@
+// Package memory keeps processed articles in process memory, optionally journaled to a JSON-lines
+// file so dedup survives restarts without a database.
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/ports"
+)
+
+// Repository implements the storage ports with maps guarded by a mutex; it is safe for concurrent use.
+type Repository struct {
+	mu          sync.RWMutex
+	processed   map[string]domain.ProcessedArticle
+	versions    map[string]map[int]bool
+	enrichments map[string]domain.Enrichment
+	outcomes    map[outcomeKey]domain.ScanOutcome
+	articles    map[string]domain.Article
+	reviews     map[reviewKey]domain.ArticleReview
+	runs        map[int64]domain.PipelineRun
+	checkpoints map[int64]map[string]domain.Checkpoint
+	lastRunID   int64
+	journal     *journal
+	now         func() time.Time
+}
+
+var _ ports.ArticleRepository = (*Repository)(nil)
+var _ ports.EnrichmentCache = (*Repository)(nil)
+var _ ports.VersionTracker = (*Repository)(nil)
+var _ ports.ScanOutcomeStore = (*Repository)(nil)
+var _ ports.ArticleArchive = (*Repository)(nil)
+var _ ports.RunStore = (*Repository)(nil)
+
+type outcomeKey struct {
+	day, site, category string
+}
+
+type reviewKey struct {
+	articleID, model string
+}
+
+// New returns an empty repository that forgets everything when the process exits.
+func New() *Repository {
+	return &Repository{
+		processed:   map[string]domain.ProcessedArticle{},
+		versions:    map[string]map[int]bool{},
+		enrichments: map[string]domain.Enrichment{},
+		outcomes:    map[outcomeKey]domain.ScanOutcome{},
+		articles:    map[string]domain.Article{},
+		reviews:     map[reviewKey]domain.ArticleReview{},
+		runs:        map[int64]domain.PipelineRun{},
+		checkpoints: map[int64]map[string]domain.Checkpoint{},
+		now:         time.Now,
+	}
+}
+
+// Processed returns the saved snapshot of an article, for tests and dry-run inspection.
+func (r *Repository) Processed(id string) (domain.ProcessedArticle, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	article, ok := r.processed[id]
+	return article, ok
+}
+
+// ProcessedArticles returns every saved snapshot ordered by article ID.
+func (r *Repository) ProcessedArticles() []domain.ProcessedArticle {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	articles := make([]domain.ProcessedArticle, 0, len(r.processed))
+	for _, article := range r.processed {
+		articles = append(articles, article)
+	}
+	sort.Slice(articles, func(i, j int) bool { return articles[i].Article.ID < articles[j].Article.ID })
+	return articles
+}
+
+// Reviews returns the archived reviews of an article ordered by model.
+func (r *Repository) Reviews(articleID string) []domain.ArticleReview {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var reviews []domain.ArticleReview
+	for key, review := range r.reviews {
+		if key.articleID == articleID {
+			reviews = append(reviews, review)
+		}
+	}
+	sort.Slice(reviews, func(i, j int) bool { return reviews[i].Model < reviews[j].Model })
+	return reviews
+}
+
+// AlreadyProcessed returns a map with IDs that were already delivered.
+func (r *Repository) AlreadyProcessed(_ context.Context, ids []string) (map[string]bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := make(map[string]bool)
+	for _, id := range ids {
+		if r.processed[id].Status == domain.StatusDelivered {
+			result[id] = true
+		}
+	}
+	return result, nil
+}
+
+// SaveProcessed upserts the processed article snapshot, keeping the original creation time.
+func (r *Repository) SaveProcessed(_ context.Context, article domain.ProcessedArticle) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	article.CreatedAt, article.UpdatedAt = now, now
+	if existing, ok := r.processed[article.Article.ID]; ok {
+		article.CreatedAt = existing.CreatedAt
+		article.Article.Title = existing.Article.Title
+	}
+	return r.commit(record{Processed: &article})
+}
+
+// UpdateStatus moves already saved articles to status.
+func (r *Repository) UpdateStatus(_ context.Context, ids []string, status domain.ProcessingStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var records []record
+	for _, id := range ids {
+		article, ok := r.processed[id]
+		if !ok {
+			continue
+		}
+		article.Status = status
+		article.UpdatedAt = r.now()
+		records = append(records, record{Processed: &article})
+	}
+	return r.commit(records...)
+}
+
+// RecordVersion stores the article version and returns the highest version seen before it.
+func (r *Repository) RecordVersion(_ context.Context, articleID string, version int) (int, error) {
+	if version <= 0 {
+		return 0, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	previous := 0
+	for seen := range r.versions[articleID] {
+		if seen > previous {
+			previous = seen
+		}
+	}
+	if r.versions[articleID][version] {
+		return previous, nil
+	}
+	if err := r.commit(record{Version: &versionRecord{ArticleID: articleID, Version: version}}); err != nil {
+		return 0, err
+	}
+	return previous, nil
+}
+
+// LoadEnrichment returns the cached enrichment for an article or nil when absent.
+func (r *Repository) LoadEnrichment(_ context.Context, articleID string) (*domain.Enrichment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	enrichment, ok := r.enrichments[articleID]
+	if !ok {
+		return nil, nil
+	}
+	return &enrichment, nil
+}
+
+// SaveEnrichment upserts the enrichment snapshot for an article.
+func (r *Repository) SaveEnrichment(_ context.Context, articleID string, enrichment domain.Enrichment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.commit(record{Enrichment: &enrichmentRecord{ArticleID: articleID, Enrichment: enrichment}})
+}
+
+// SaveScanOutcomes upserts per-site and per-category outcomes keyed by the run's calendar day.
+func (r *Repository) SaveScanOutcomes(_ context.Context, outcomes []domain.ScanOutcome) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	records := make([]record, len(outcomes))
+	for i := range outcomes {
+		records[i] = record{Outcome: &outcomes[i]}
+	}
+	return r.commit(records...)
+}
+
+// LoadScanOutcomes returns the outcomes stored for the day's calendar date ordered by site and category.
+func (r *Repository) LoadScanOutcomes(_ context.Context, day time.Time) ([]domain.ScanOutcome, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	date := day.Format("2006-01-02")
+	var outcomes []domain.ScanOutcome
+	for key, outcome := range r.outcomes {
+		if key.day == date {
+			outcome.Day = day
+			outcomes = append(outcomes, outcome)
+		}
+	}
+	sort.Slice(outcomes, func(i, j int) bool {
+		if outcomes[i].Site != outcomes[j].Site {
+			return outcomes[i].Site < outcomes[j].Site
+		}
+		return outcomes[i].Category < outcomes[j].Category
+	})
+	return outcomes, nil
+}
+
+// SaveArticle upserts the article metadata.
+func (r *Repository) SaveArticle(_ context.Context, article domain.Article) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.commit(record{Article: &article})
+}
+
+// SaveReview upserts the review of an already saved article, one per model.
+func (r *Repository) SaveReview(_ context.Context, review domain.ArticleReview) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.articles[review.Article.ID]; !ok {
+		return fmt.Errorf("save review: article %s is not archived", review.Article.ID)
+	}
+	if review.RankedAt.IsZero() {
+		review.RankedAt = r.now()
+	}
+	return r.commit(record{Review: &review})
+}
+
+// StartRun records a new pipeline run and returns its ID.
+func (r *Repository) StartRun(_ context.Context, run domain.PipelineRun) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	run.ID = r.lastRunID + 1
+	if err := r.commit(newRunRecord(run)); err != nil {
+		return 0, err
+	}
+	return run.ID, nil
+}
+
+// UpdateRun stores the run's status, stage counts and errors.
+func (r *Repository) UpdateRun(_ context.Context, run domain.PipelineRun) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.runs[run.ID]
+	if !ok {
+		return nil
+	}
+	stored.FinishedAt = run.FinishedAt
+	stored.Status = run.Status
+	stored.Fetched, stored.Ranked, stored.Summarized, stored.Delivered = run.Fetched, run.Ranked, run.Summarized, run.Delivered
+	stored.Errors = append([]string(nil), run.Errors...)
+	return r.commit(newRunRecord(stored))
+}
+
+// UnfinishedRuns returns running and failed runs, oldest first.
+func (r *Repository) UnfinishedRuns(_ context.Context) ([]domain.PipelineRun, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var runs []domain.PipelineRun
+	for _, run := range r.runs {
+		if !run.Finished() {
+			run.Errors = append([]string(nil), run.Errors...)
+			runs = append(runs, run)
+		}
+	}
+	sort.Slice(runs, func(i, j int) bool {
+		if !runs[i].StartedAt.Equal(runs[j].StartedAt) {
+			return runs[i].StartedAt.Before(runs[j].StartedAt)
+		}
+		return runs[i].ID < runs[j].ID
+	})
+	return runs, nil
+}
+
+// SaveCheckpoint upserts the article's checkpoint within its run.
+func (r *Repository) SaveCheckpoint(_ context.Context, checkpoint domain.Checkpoint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.commit(record{Checkpoint: &checkpoint})
+}
+
+// LoadCheckpoints returns the run's checkpoints in the order the articles were fetched.
+func (r *Repository) LoadCheckpoints(_ context.Context, runID int64) ([]domain.Checkpoint, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	checkpoints := make([]domain.Checkpoint, 0, len(r.checkpoints[runID]))
+	for _, checkpoint := range r.checkpoints[runID] {
+		checkpoints = append(checkpoints, checkpoint)
+	}
+	sort.Slice(checkpoints, func(i, j int) bool { return checkpoints[i].Position < checkpoints[j].Position })
+	return checkpoints, nil
+}
+
+// commit journals the records, when a journal is attached, and then applies them; callers hold mu.
+func (r *Repository) commit(records ...record) error {
+	if len(records) == 0 {
+		return nil
+	}
+	if r.journal != nil {
+		if err := r.journal.append(records); err != nil {
+			return err
+		}
+	}
+	for _, rec := range records {
+		r.apply(rec)
+	}
+	return nil
+}
+
+// apply stores the entity snapshot carried by rec, replacing any earlier one with the same key.
+func (r *Repository) apply(rec record) {
+	switch {
+	case rec.Processed != nil:
+		r.processed[rec.Processed.Article.ID] = *rec.Processed
+	case rec.Version != nil:
+		if r.versions[rec.Version.ArticleID] == nil {
+			r.versions[rec.Version.ArticleID] = map[int]bool{}
+		}
+		r.versions[rec.Version.ArticleID][rec.Version.Version] = true
+	case rec.Enrichment != nil:
+		r.enrichments[rec.Enrichment.ArticleID] = rec.Enrichment.Enrichment
+	case rec.Outcome != nil:
+		key := outcomeKey{day: rec.Outcome.Day.Format("2006-01-02"), site: rec.Outcome.Site, category: rec.Outcome.Category}
+		r.outcomes[key] = *rec.Outcome
+	case rec.Article != nil:
+		r.articles[rec.Article.ID] = *rec.Article
+	case rec.Review != nil:
+		r.reviews[reviewKey{articleID: rec.Review.Article.ID, model: rec.Review.Model}] = *rec.Review
+	case rec.Run != nil:
+		run := rec.Run.Run
+		if loc, err := time.LoadLocation(rec.Run.Timezone); err == nil {
+			run.Day = run.Day.In(loc)
+		}
+		r.runs[run.ID] = run
+		if run.ID > r.lastRunID {
+			r.lastRunID = run.ID
+		}
+	case rec.Checkpoint != nil:
+		if r.checkpoints[rec.Checkpoint.RunID] == nil {
+			r.checkpoints[rec.Checkpoint.RunID] = map[string]domain.Checkpoint{}
+		}
+		r.checkpoints[rec.Checkpoint.RunID][rec.Checkpoint.Review.Article.ID] = *rec.Checkpoint
+	}
+}
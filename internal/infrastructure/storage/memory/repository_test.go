@@ -0,0 +1,151 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/infrastructure/storage/storagetest"
+)
+
+func TestRepositoryContract(t *testing.T) {
+	t.Parallel()
+
+	storagetest.Run(t, func(t *testing.T) storagetest.Repository {
+		return New()
+	})
+}
+
+func TestJournalRepositoryContract(t *testing.T) {
+	t.Parallel()
+
+	storagetest.Run(t, func(t *testing.T) storagetest.Repository {
+		repo, err := Open(filepath.Join(t.TempDir(), "articles.jsonl"))
+		if err != nil {
+			t.Fatalf("Open error: %v", err)
+		}
+		t.Cleanup(func() { _ = repo.Close() })
+		return repo
+	})
+}
+
+func TestJournalSurvivesReopen(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "articles.jsonl")
+	repo, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+	for _, id := range []string{"a", "b"} {
+		if err := repo.SaveProcessed(ctx, domain.ProcessedArticle{Article: domain.Article{ID: id, Title: "Title " + id}, Status: domain.StatusSummarized, Summary: "short"}); err != nil {
+			t.Fatalf("SaveProcessed error: %v", err)
+		}
+	}
+	if err := repo.UpdateStatus(ctx, []string{"a"}, domain.StatusDelivered); err != nil {
+		t.Fatalf("UpdateStatus error: %v", err)
+	}
+	if _, err := repo.RecordVersion(ctx, "a", 2); err != nil {
+		t.Fatalf("RecordVersion error: %v", err)
+	}
+	runID, err := repo.StartRun(ctx, domain.PipelineRun{Day: time.Date(2025, time.November, 11, 6, 0, 0, 0, moscow), Status: domain.RunRunning})
+	if err != nil {
+		t.Fatalf("StartRun error: %v", err)
+	}
+	if err := repo.Close(); err != nil {
+		t.Fatalf("Close error: %v", err)
+	}
+
+	// A crash mid-write leaves a torn final line, which must not block the restart.
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatalf("open journal: %v", err)
+	}
+	if _, err := file.WriteString(`{"processed":{"Article":{"ID":"c"`); err != nil {
+		t.Fatalf("write torn line: %v", err)
+	}
+	_ = file.Close()
+
+	reopened, err := Open(path)
+	if err != nil {
+		t.Fatalf("reopen error: %v", err)
+	}
+	defer reopened.Close()
+
+	processed, err := reopened.AlreadyProcessed(ctx, []string{"a", "b", "c"})
+	if err != nil || len(processed) != 1 || !processed["a"] {
+		t.Fatalf("expected dedup to survive restart, got %v, err %v", processed, err)
+	}
+	if article, ok := reopened.Processed("b"); !ok || article.Status != domain.StatusSummarized || article.Summary != "short" {
+		t.Fatalf("unexpected snapshot of b: %+v", article)
+	}
+	if previous, err := reopened.RecordVersion(ctx, "a", 3); err != nil || previous != 2 {
+		t.Fatalf("RecordVersion after reopen = %d, err %v", previous, err)
+	}
+	runs, err := reopened.UnfinishedRuns(ctx)
+	if err != nil || len(runs) != 1 || runs[0].ID != runID || runs[0].Day.Location().String() != "Europe/Moscow" {
+		t.Fatalf("unexpected runs after reopen: %+v, err %v", runs, err)
+	}
+	if next, err := reopened.StartRun(ctx, domain.PipelineRun{Status: domain.RunRunning}); err != nil || next != runID+1 {
+		t.Fatalf("StartRun after reopen = %d, err %v", next, err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read journal: %v", err)
+	}
+	if lines := strings.Count(string(data), "\n"); lines != 6 {
+		t.Fatalf("expected the compacted journal plus the new version and run, got %d lines:\n%s", lines, data)
+	}
+}
+
+func TestRepositoryConcurrentWrites(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo, err := Open(filepath.Join(t.TempDir(), "articles.jsonl"))
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	defer repo.Close()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			if err := repo.SaveProcessed(ctx, domain.ProcessedArticle{Article: domain.Article{ID: id}, Status: domain.StatusFetched}); err != nil {
+				t.Errorf("SaveProcessed error: %v", err)
+			}
+			if err := repo.UpdateStatus(ctx, []string{id}, domain.StatusDelivered); err != nil {
+				t.Errorf("UpdateStatus error: %v", err)
+			}
+			if _, err := repo.AlreadyProcessed(ctx, []string{id}); err != nil {
+				t.Errorf("AlreadyProcessed error: %v", err)
+			}
+		}(fmt.Sprintf("id-%02d", i))
+	}
+	wg.Wait()
+
+	articles := repo.ProcessedArticles()
+	if len(articles) != 20 || articles[0].Article.ID != "id-00" {
+		t.Fatalf("unexpected articles: %d", len(articles))
+	}
+	for _, article := range articles {
+		if article.Status != domain.StatusDelivered {
+			t.Fatalf("expected every article delivered, got %+v", article)
+		}
+	}
+}
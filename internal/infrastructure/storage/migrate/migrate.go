@@ -0,0 +1,379 @@
+// Package migrate applies embedded SQL migrations and records them in schema_migrations.
+package migrate
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+const table = "schema_migrations"
+
+// fileName matches NNN_description.up.sql and NNN_description.down.sql.
+var fileName = regexp.MustCompile(`^(\d+)_([A-Za-z0-9_\-]+)\.(up|down)\.sql$`)
+
+// ErrChecksumMismatch reports an applied migration whose up file changed afterwards.
+var ErrChecksumMismatch = errors.New("migration checksum mismatch")
+
+// Dialect holds the database-specific bits of the runner.
+type Dialect struct {
+	Placeholder sq.PlaceholderFormat
+	// CreateTable creates schema_migrations when it is missing.
+	CreateTable string
+	// Lock and Unlock serialise concurrent runners on one connection; empty means no locking.
+	Lock   string
+	Unlock string
+}
+
+// Postgres guards runs with a session advisory lock so two instances starting together do not race.
+var Postgres = Dialect{
+	Placeholder: sq.Dollar,
+	CreateTable: `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version    BIGINT PRIMARY KEY,
+    name       TEXT        NOT NULL,
+    checksum   TEXT        NOT NULL,
+    applied_at TIMESTAMPTZ NOT NULL
+)`,
+	Lock:   "SELECT pg_advisory_lock(7236145)",
+	Unlock: "SELECT pg_advisory_unlock(7236145)",
+}
+
+// SQLite relies on SQLite's single-writer locking, so no explicit lock is taken.
+var SQLite = Dialect{
+	Placeholder: sq.Question,
+	CreateTable: `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version    INTEGER PRIMARY KEY,
+    name       TEXT      NOT NULL,
+    checksum   TEXT      NOT NULL,
+    applied_at TIMESTAMP NOT NULL
+)`,
+}
+
+// Migration is one numbered schema change; Checksum is the SHA-256 of the up script.
+type Migration struct {
+	Version  int64
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+}
+
+// Status pairs a known migration with whether and when it was applied.
+type Status struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// Runner applies migrations in version order, each in its own transaction.
+type Runner struct {
+	db         *sql.DB
+	dialect    Dialect
+	builder    sq.StatementBuilderType
+	migrations []Migration
+	now        func() time.Time
+	logger     *slog.Logger
+}
+
+// New loads the migrations found at the root of fsys.
+func New(db *sql.DB, fsys fs.FS, dialect Dialect, log *slog.Logger) (*Runner, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Runner{
+		db:         db,
+		dialect:    dialect,
+		builder:    sq.StatementBuilder.PlaceholderFormat(dialect.Placeholder),
+		migrations: migrations,
+		now:        time.Now,
+		logger:     log,
+	}, nil
+}
+
+// Load parses and validates the migration files at the root of fsys, ordered by version.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("read migrations: %w", err)
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := fileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s: bad version: %w", entry.Name(), err)
+		}
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("read migration %s: %w", entry.Name(), err)
+		}
+
+		migration := byVersion[version]
+		if migration == nil {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has conflicting names %q and %q", version, migration.Name, match[2])
+		}
+		if match[3] == "up" {
+			migration.Up = string(content)
+			sum := sha256.Sum256(content)
+			migration.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Checksum == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up script", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Up applies every pending migration and returns how many were applied.
+func (r *Runner) Up(ctx context.Context) (int, error) {
+	applied := 0
+	err := r.locked(ctx, func(conn *sql.Conn) error {
+		done, err := r.verify(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range r.migrations {
+			if _, ok := done[migration.Version]; ok {
+				continue
+			}
+			if err := r.apply(ctx, conn, migration); err != nil {
+				return err
+			}
+			applied++
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down reverts the latest steps applied migrations and returns how many were reverted.
+func (r *Runner) Down(ctx context.Context, steps int) (int, error) {
+	reverted := 0
+	err := r.locked(ctx, func(conn *sql.Conn) error {
+		done, err := r.verify(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for i := len(r.migrations) - 1; i >= 0 && reverted < steps; i-- {
+			migration := r.migrations[i]
+			if _, ok := done[migration.Version]; !ok {
+				continue
+			}
+			if err := r.revert(ctx, conn, migration); err != nil {
+				return err
+			}
+			reverted++
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Status lists every known migration with its applied state, failing on checksum mismatches.
+func (r *Runner) Status(ctx context.Context) ([]Status, error) {
+	var statuses []Status
+	err := r.locked(ctx, func(conn *sql.Conn) error {
+		done, err := r.verify(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range r.migrations {
+			appliedAt, ok := done[migration.Version]
+			statuses = append(statuses, Status{Migration: migration, Applied: ok, AppliedAt: appliedAt})
+		}
+		return nil
+	})
+	return statuses, err
+}
+
+// locked runs fn on a single connection holding the dialect lock and an existing schema_migrations table.
+func (r *Runner) locked(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("open migration connection: %w", err)
+	}
+	defer conn.Close()
+
+	if r.dialect.Lock != "" {
+		if _, err := conn.ExecContext(ctx, r.dialect.Lock); err != nil {
+			return fmt.Errorf("acquire migration lock: %w", err)
+		}
+		defer func() {
+			if _, err := conn.ExecContext(context.WithoutCancel(ctx), r.dialect.Unlock); err != nil {
+				r.warn("release migration lock failed", "error", err)
+			}
+		}()
+	}
+
+	if _, err := conn.ExecContext(ctx, r.dialect.CreateTable); err != nil {
+		return fmt.Errorf("create %s: %w", table, err)
+	}
+	return fn(conn)
+}
+
+// verify loads applied versions and checks their checksums against the embedded files.
+func (r *Runner) verify(ctx context.Context, conn *sql.Conn) (map[int64]time.Time, error) {
+	query, args, err := r.builder.
+		Select("version", "checksum", "applied_at").
+		From(table).
+		OrderBy("version").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build %s query: %w", table, err)
+	}
+
+	rows, err := conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query %s: %w", table, err)
+	}
+
+	type record struct {
+		checksum  string
+		appliedAt time.Time
+	}
+	applied := map[int64]record{}
+	for rows.Next() {
+		var (
+			version int64
+			rec     record
+		)
+		if err := rows.Scan(&version, &rec.checksum, &rec.appliedAt); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("scan %s: %w", table, err)
+		}
+		applied[version] = rec
+	}
+	if rowsErr := rows.Err(); rowsErr != nil {
+		_ = rows.Close()
+		return nil, fmt.Errorf("rows iteration: %w", rowsErr)
+	}
+	if closeErr := rows.Close(); closeErr != nil {
+		return nil, fmt.Errorf("close rows: %w", closeErr)
+	}
+
+	known := map[int64]bool{}
+	done := make(map[int64]time.Time, len(applied))
+	for _, migration := range r.migrations {
+		known[migration.Version] = true
+		rec, ok := applied[migration.Version]
+		if !ok {
+			continue
+		}
+		if rec.checksum != migration.Checksum {
+			return nil, fmt.Errorf("%w: %d_%s was changed after it was applied", ErrChecksumMismatch, migration.Version, migration.Name)
+		}
+		done[migration.Version] = rec.appliedAt
+	}
+	for version := range applied {
+		if !known[version] {
+			r.warn("database has a migration unknown to this build", "version", version)
+		}
+	}
+	return done, nil
+}
+
+func (r *Runner) apply(ctx context.Context, conn *sql.Conn, migration Migration) error {
+	insert, args, err := r.builder.
+		Insert(table).
+		Columns("version", "name", "checksum", "applied_at").
+		Values(migration.Version, migration.Name, migration.Checksum, r.now().UTC()).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build %s insert: %w", table, err)
+	}
+
+	if err := inTx(ctx, conn, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, migration.Up); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, insert, args...)
+		return err
+	}); err != nil {
+		return fmt.Errorf("apply migration %d_%s: %w", migration.Version, migration.Name, err)
+	}
+	r.info("applied migration", "version", migration.Version, "name", migration.Name)
+	return nil
+}
+
+func (r *Runner) revert(ctx context.Context, conn *sql.Conn, migration Migration) error {
+	if migration.Down == "" {
+		return fmt.Errorf("migration %d_%s has no down script", migration.Version, migration.Name)
+	}
+	remove, args, err := r.builder.
+		Delete(table).
+		Where(sq.Eq{"version": migration.Version}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build %s delete: %w", table, err)
+	}
+
+	if err := inTx(ctx, conn, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, migration.Down); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, remove, args...)
+		return err
+	}); err != nil {
+		return fmt.Errorf("revert migration %d_%s: %w", migration.Version, migration.Name, err)
+	}
+	r.info("reverted migration", "version", migration.Version, "name", migration.Name)
+	return nil
+}
+
+func inTx(ctx context.Context, conn *sql.Conn, fn func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+	return nil
+}
+
+func (r *Runner) info(msg string, args ...interface{}) {
+	if r.logger != nil {
+		r.logger.Info(msg, args...)
+	}
+}
+
+func (r *Runner) warn(msg string, args ...interface{}) {
+	if r.logger != nil {
+		r.logger.Warn(msg, args...)
+	}
+}
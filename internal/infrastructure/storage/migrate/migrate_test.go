@@ -0,0 +1,170 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	_ "modernc.org/sqlite"
+
+	"ArticlesScanner/migrations"
+)
+
+func TestLoadOrdersAndPairsFiles(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"010_reviews.up.sql":   {Data: []byte("CREATE TABLE reviews (id INT);")},
+		"010_reviews.down.sql": {Data: []byte("DROP TABLE reviews;")},
+		"002_init.up.sql":      {Data: []byte("CREATE TABLE articles (id INT);")},
+		"embed.go":             {Data: []byte("package migrations")},
+		"README.md":            {Data: []byte("notes")},
+	}
+
+	loaded, err := Load(fsys)
+	if err != nil {
+		t.Fatalf("Load error: %v", err)
+	}
+	if len(loaded) != 2 || loaded[0].Version != 2 || loaded[1].Version != 10 {
+		t.Fatalf("unexpected migrations: %+v", loaded)
+	}
+	if loaded[0].Down != "" || loaded[1].Down != "DROP TABLE reviews;" || loaded[1].Name != "reviews" {
+		t.Fatalf("unexpected pairing: %+v", loaded)
+	}
+	if len(loaded[0].Checksum) != 64 || loaded[0].Checksum == loaded[1].Checksum {
+		t.Fatalf("unexpected checksums: %q %q", loaded[0].Checksum, loaded[1].Checksum)
+	}
+}
+
+func TestLoadRejectsInvalidSets(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]fstest.MapFS{
+		"no up script": {
+			"001_init.down.sql": {Data: []byte("DROP TABLE x;")},
+		},
+		"conflicting names": {
+			"001_init.up.sql":  {Data: []byte("CREATE TABLE x (id INT);")},
+			"001_other.up.sql": {Data: []byte("CREATE TABLE y (id INT);")},
+		},
+	}
+	for name, fsys := range cases {
+		if _, err := Load(fsys); err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+	}
+}
+
+func TestEmbeddedMigrationsAreReversible(t *testing.T) {
+	t.Parallel()
+
+	postgres, err := Load(migrations.Postgres)
+	if err != nil {
+		t.Fatalf("Load error: %v", err)
+	}
+	sqlite, err := Load(migrations.SQLite)
+	if err != nil {
+		t.Fatalf("Load sqlite error: %v", err)
+	}
+	if len(sqlite) != len(postgres) {
+		t.Fatalf("sqlite has %d migrations, postgres %d", len(sqlite), len(postgres))
+	}
+	for i, migration := range append(postgres, sqlite...) {
+		i %= len(postgres)
+		if migration.Name != postgres[i].Name {
+			t.Fatalf("migration %d is %s in sqlite but %s in postgres", migration.Version, migration.Name, postgres[i].Name)
+		}
+		if migration.Version != int64(i+1) {
+			t.Fatalf("expected contiguous versions, got %d at position %d", migration.Version, i)
+		}
+		if migration.Down == "" {
+			t.Fatalf("migration %d_%s has no down script", migration.Version, migration.Name)
+		}
+		if strings.Contains(strings.ToUpper(migration.Up), "COMMIT;") {
+			t.Fatalf("migration %d_%s manages its own transaction", migration.Version, migration.Name)
+		}
+	}
+}
+
+// TestRunnerPostgres runs the embedded migrations against a scratch database named by TEST_DATABASE_DSN.
+func TestRunnerPostgres(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN not set")
+	}
+
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	defer db.Close()
+
+	exerciseRunner(t, db, migrations.Postgres, Postgres)
+}
+
+func TestRunnerSQLite(t *testing.T) {
+	t.Parallel()
+
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "migrate.db")+"?_pragma=foreign_keys(1)&_time_format=sqlite")
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	defer db.Close()
+
+	exerciseRunner(t, db, migrations.SQLite, SQLite)
+}
+
+// exerciseRunner migrates down to empty, up, down one step and up again, then detects an edited file.
+func exerciseRunner(t *testing.T, db *sql.DB, fsys fs.FS, dialect Dialect) {
+	t.Helper()
+
+	ctx := context.Background()
+	runner, err := New(db, fsys, dialect, nil)
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+	if _, err := runner.Down(ctx, len(runner.migrations)); err != nil {
+		t.Fatalf("reset error: %v", err)
+	}
+
+	applied, err := runner.Up(ctx)
+	if err != nil || applied != len(runner.migrations) {
+		t.Fatalf("Up applied %d, err %v", applied, err)
+	}
+	if applied, err := runner.Up(ctx); err != nil || applied != 0 {
+		t.Fatalf("second Up applied %d, err %v", applied, err)
+	}
+
+	if reverted, err := runner.Down(ctx, 1); err != nil || reverted != 1 {
+		t.Fatalf("Down reverted %d, err %v", reverted, err)
+	}
+	statuses, err := runner.Status(ctx)
+	if err != nil {
+		t.Fatalf("Status error: %v", err)
+	}
+	if last := statuses[len(statuses)-1]; last.Applied || !statuses[0].Applied || statuses[0].AppliedAt.IsZero() {
+		t.Fatalf("unexpected status after Down: %+v", statuses)
+	}
+	if _, err := runner.Up(ctx); err != nil {
+		t.Fatalf("re-apply error: %v", err)
+	}
+
+	if reverted, err := runner.Down(ctx, len(runner.migrations)); err != nil || reverted != len(runner.migrations) {
+		t.Fatalf("full Down reverted %d, err %v", reverted, err)
+	}
+	if _, err := runner.Up(ctx); err != nil {
+		t.Fatalf("Up after full Down error: %v", err)
+	}
+
+	runner.migrations[0].Checksum = "edited"
+	if _, err := runner.Up(ctx); !errors.Is(err, ErrChecksumMismatch) {
+		t.Fatalf("expected checksum mismatch, got %v", err)
+	}
+}
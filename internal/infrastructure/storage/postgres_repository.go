@@ -3,7 +3,10 @@ package storage
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 
@@ -17,6 +20,10 @@ type PostgresRepository struct {
 }
 
 var _ ports.ArticleRepository = (*PostgresRepository)(nil)
+var _ ports.EnrichmentCache = (*PostgresRepository)(nil)
+var _ ports.VersionTracker = (*PostgresRepository)(nil)
+var _ ports.ScanOutcomeStore = (*PostgresRepository)(nil)
+var _ ports.ArticleArchive = (*PostgresRepository)(nil)
 
 // NewPostgresRepository wires a sql.DB implementation.
 func NewPostgresRepository(db *sql.DB) *PostgresRepository {
@@ -25,7 +32,7 @@ func NewPostgresRepository(db *sql.DB) *PostgresRepository {
 
 var psql = sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 
-// AlreadyProcessed returns a map with IDs that already exist in storage.
+// AlreadyProcessed returns a map with IDs that were already delivered.
 func (r *PostgresRepository) AlreadyProcessed(ctx context.Context, ids []string) (map[string]bool, error) {
 	if r.db == nil || len(ids) == 0 {
 		return map[string]bool{}, nil
@@ -34,7 +41,7 @@ func (r *PostgresRepository) AlreadyProcessed(ctx context.Context, ids []string)
 	query, args, err := psql.
 		Select("external_id").
 		From("processed_articles").
-		Where(sq.Eq{"external_id": ids}).
+		Where(sq.Eq{"external_id": ids, "status": string(domain.StatusDelivered)}).
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("build processed query: %w", err)
@@ -73,17 +80,39 @@ func (r *PostgresRepository) SaveProcessed(ctx context.Context, article domain.P
 		return nil
 	}
 
+	authors, err := jsonList(article.Article.Authors)
+	if err != nil {
+		return fmt.Errorf("encode authors: %w", err)
+	}
+	categories, err := jsonList(article.Article.SecondaryCategories)
+	if err != nil {
+		return fmt.Errorf("encode categories: %w", err)
+	}
+
 	query, args, err := psql.
 		Insert("processed_articles").
-		Columns("external_id", "title", "summary", "score", "status").
+		Columns("external_id", "title", "summary", "score", "status",
+			"url", "authors", "primary_category", "secondary_categories", "comments", "journal_ref",
+			"version", "announcement").
 		Values(
 			article.Article.ID,
 			article.Article.Title,
 			article.Summary,
 			article.Score,
 			article.Status,
+			article.Article.URL,
+			authors,
+			article.Article.PrimaryCategory,
+			categories,
+			article.Article.Comments,
+			article.Article.JournalRef,
+			article.Article.Version,
+			string(article.Article.Announcement),
 		).
-		Suffix("ON CONFLICT (external_id) DO UPDATE SET summary = EXCLUDED.summary, score = EXCLUDED.score, status = EXCLUDED.status, updated_at = NOW()").
+		Suffix("ON CONFLICT (external_id) DO UPDATE SET summary = EXCLUDED.summary, score = EXCLUDED.score, status = EXCLUDED.status, " +
+			"url = EXCLUDED.url, authors = EXCLUDED.authors, primary_category = EXCLUDED.primary_category, " +
+			"secondary_categories = EXCLUDED.secondary_categories, comments = EXCLUDED.comments, journal_ref = EXCLUDED.journal_ref, " +
+			"version = EXCLUDED.version, announcement = EXCLUDED.announcement, updated_at = NOW()").
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("build upsert processed: %w", err)
@@ -95,3 +124,316 @@ func (r *PostgresRepository) SaveProcessed(ctx context.Context, article domain.P
 
 	return nil
 }
+
+// UpdateStatus moves already saved articles to status.
+func (r *PostgresRepository) UpdateStatus(ctx context.Context, ids []string, status domain.ProcessingStatus) error {
+	if r.db == nil || len(ids) == 0 {
+		return nil
+	}
+
+	query, args, err := psql.
+		Update("processed_articles").
+		Set("status", string(status)).
+		Where(sq.Eq{"external_id": ids}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build update status: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("update status: %w", err)
+	}
+	return nil
+}
+
+// SaveArticle upserts the article metadata into the articles table.
+func (r *PostgresRepository) SaveArticle(ctx context.Context, article domain.Article) error {
+	if r.db == nil {
+		return nil
+	}
+
+	authors, err := jsonList(article.Authors)
+	if err != nil {
+		return fmt.Errorf("encode authors: %w", err)
+	}
+	categories, err := jsonList(articleCategories(article))
+	if err != nil {
+		return fmt.Errorf("encode categories: %w", err)
+	}
+
+	query, args, err := psql.
+		Insert("articles").
+		Columns("external_id", "title", "abstract", "url", "source", "authors", "primary_category", "categories",
+			"doi", "venue", "version", "announcement", "published_at").
+		Values(
+			article.ID,
+			article.Title,
+			article.Abstract,
+			article.URL,
+			article.Source,
+			authors,
+			article.PrimaryCategory,
+			categories,
+			article.DOI,
+			article.Venue,
+			article.Version,
+			string(article.Announcement),
+			sql.NullTime{Time: article.PublishedAt, Valid: !article.PublishedAt.IsZero()},
+		).
+		Suffix("ON CONFLICT (external_id) DO UPDATE SET title = EXCLUDED.title, abstract = EXCLUDED.abstract, url = EXCLUDED.url, " +
+			"source = EXCLUDED.source, authors = EXCLUDED.authors, primary_category = EXCLUDED.primary_category, " +
+			"categories = EXCLUDED.categories, doi = EXCLUDED.doi, venue = EXCLUDED.venue, version = EXCLUDED.version, " +
+			"announcement = EXCLUDED.announcement, published_at = EXCLUDED.published_at, updated_at = NOW()").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build upsert article: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("upsert article: %w", err)
+	}
+	return nil
+}
+
+// SaveReview upserts the review of an already saved article, one row per model.
+func (r *PostgresRepository) SaveReview(ctx context.Context, review domain.ArticleReview) error {
+	if r.db == nil {
+		return nil
+	}
+
+	topics, err := jsonList(review.Topics)
+	if err != nil {
+		return fmt.Errorf("encode topics: %w", err)
+	}
+	rankedAt := review.RankedAt
+	if rankedAt.IsZero() {
+		rankedAt = time.Now()
+	}
+
+	query, args, err := psql.
+		Insert("reviews").
+		Columns("external_id", "model", "score", "topics", "summary", "ranked_at").
+		Values(review.Article.ID, review.Model, review.Score, topics, review.Summary, rankedAt).
+		Suffix("ON CONFLICT (external_id, model) DO UPDATE SET score = EXCLUDED.score, topics = EXCLUDED.topics, " +
+			"summary = EXCLUDED.summary, ranked_at = EXCLUDED.ranked_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build upsert review: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("upsert review: %w", err)
+	}
+	return nil
+}
+
+// RecordVersion stores the article version and returns the highest version seen before it.
+func (r *PostgresRepository) RecordVersion(ctx context.Context, articleID string, version int) (int, error) {
+	if r.db == nil || version <= 0 {
+		return 0, nil
+	}
+
+	query, args, err := psql.
+		Select("COALESCE(MAX(version), 0)").
+		From("article_versions").
+		Where(sq.Eq{"external_id": articleID}).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build version query: %w", err)
+	}
+
+	var previous int
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&previous); err != nil {
+		return 0, fmt.Errorf("query version: %w", err)
+	}
+
+	insert, args, err := psql.
+		Insert("article_versions").
+		Columns("external_id", "version").
+		Values(articleID, version).
+		Suffix("ON CONFLICT (external_id, version) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build insert version: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, insert, args...); err != nil {
+		return 0, fmt.Errorf("insert version: %w", err)
+	}
+
+	return previous, nil
+}
+
+// SaveScanOutcomes upserts per-site and per-category outcomes keyed by the run's calendar day.
+func (r *PostgresRepository) SaveScanOutcomes(ctx context.Context, outcomes []domain.ScanOutcome) error {
+	if r.db == nil || len(outcomes) == 0 {
+		return nil
+	}
+
+	insert := psql.
+		Insert("scan_outcomes").
+		Columns("run_day", "site", "category", "articles", "error", "scanned_at")
+	for _, outcome := range latestOutcomes(outcomes) {
+		insert = insert.Values(
+			outcome.Day.Format("2006-01-02"),
+			outcome.Site,
+			outcome.Category,
+			outcome.Articles,
+			sql.NullString{String: outcome.Error, Valid: outcome.Error != ""},
+			outcome.ScannedAt,
+		)
+	}
+	query, args, err := insert.
+		Suffix("ON CONFLICT (run_day, site, category) DO UPDATE SET articles = EXCLUDED.articles, " +
+			"error = EXCLUDED.error, scanned_at = EXCLUDED.scanned_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build upsert scan outcomes: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("upsert scan outcomes: %w", err)
+	}
+	return nil
+}
+
+// LoadScanOutcomes returns the outcomes stored for the day's calendar date.
+func (r *PostgresRepository) LoadScanOutcomes(ctx context.Context, day time.Time) ([]domain.ScanOutcome, error) {
+	if r.db == nil {
+		return nil, nil
+	}
+
+	query, args, err := psql.
+		Select("site", "category", "articles", "COALESCE(error, '')", "scanned_at").
+		From("scan_outcomes").
+		Where(sq.Eq{"run_day": day.Format("2006-01-02")}).
+		OrderBy("site", "category").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build scan outcomes query: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query scan outcomes: %w", err)
+	}
+
+	var outcomes []domain.ScanOutcome
+	for rows.Next() {
+		outcome := domain.ScanOutcome{Day: day}
+		if err := rows.Scan(&outcome.Site, &outcome.Category, &outcome.Articles, &outcome.Error, &outcome.ScannedAt); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("scan outcome: %w", err)
+		}
+		outcomes = append(outcomes, outcome)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		_ = rows.Close()
+		return nil, fmt.Errorf("rows iteration: %w", rowsErr)
+	}
+
+	if closeErr := rows.Close(); closeErr != nil {
+		return nil, fmt.Errorf("close rows: %w", closeErr)
+	}
+
+	return outcomes, nil
+}
+
+// LoadEnrichment returns the cached enrichment for an article or nil when absent.
+func (r *PostgresRepository) LoadEnrichment(ctx context.Context, articleID string) (*domain.Enrichment, error) {
+	if r.db == nil {
+		return nil, nil
+	}
+
+	query, args, err := psql.
+		Select("payload").
+		From("article_enrichments").
+		Where(sq.Eq{"external_id": articleID}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build enrichment query: %w", err)
+	}
+
+	var payload []byte
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&payload); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("query enrichment: %w", err)
+	}
+
+	var enrichment domain.Enrichment
+	if err := json.Unmarshal(payload, &enrichment); err != nil {
+		return nil, fmt.Errorf("decode enrichment: %w", err)
+	}
+	return &enrichment, nil
+}
+
+// SaveEnrichment upserts the enrichment snapshot for an article.
+func (r *PostgresRepository) SaveEnrichment(ctx context.Context, articleID string, enrichment domain.Enrichment) error {
+	if r.db == nil {
+		return nil
+	}
+
+	payload, err := json.Marshal(enrichment)
+	if err != nil {
+		return fmt.Errorf("encode enrichment: %w", err)
+	}
+
+	query, args, err := psql.
+		Insert("article_enrichments").
+		Columns("external_id", "provider", "payload", "fetched_at").
+		Values(articleID, enrichment.Provider, payload, enrichment.FetchedAt).
+		Suffix("ON CONFLICT (external_id) DO UPDATE SET provider = EXCLUDED.provider, payload = EXCLUDED.payload, fetched_at = EXCLUDED.fetched_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build upsert enrichment: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("upsert enrichment: %w", err)
+	}
+
+	return nil
+}
+
+// latestOutcomes keeps the last outcome per (day, site, category), because a single ON CONFLICT
+// statement cannot update the same row twice.
+func latestOutcomes(outcomes []domain.ScanOutcome) []domain.ScanOutcome {
+	type key struct{ day, site, category string }
+	index := make(map[key]int, len(outcomes))
+	latest := make([]domain.ScanOutcome, 0, len(outcomes))
+	for _, outcome := range outcomes {
+		k := key{outcome.Day.Format("2006-01-02"), outcome.Site, outcome.Category}
+		if i, ok := index[k]; ok {
+			latest[i] = outcome
+			continue
+		}
+		index[k] = len(latest)
+		latest = append(latest, outcome)
+	}
+	return latest
+}
+
+// articleCategories lists the primary category first, followed by the secondary ones.
+func articleCategories(article domain.Article) []string {
+	categories := make([]string, 0, len(article.SecondaryCategories)+1)
+	if article.PrimaryCategory != "" {
+		categories = append(categories, article.PrimaryCategory)
+	}
+	for _, category := range article.SecondaryCategories {
+		if category != article.PrimaryCategory {
+			categories = append(categories, category)
+		}
+	}
+	return categories
+}
+
+// jsonList encodes list columns as JSON arrays, never null.
+func jsonList(values []string) ([]byte, error) {
+	if values == nil {
+		values = []string{}
+	}
+	return json.Marshal(values)
+}
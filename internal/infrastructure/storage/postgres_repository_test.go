@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+	"time"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/infrastructure/storage/migrate"
+	"ArticlesScanner/internal/infrastructure/storage/storagetest"
+	"ArticlesScanner/migrations"
+)
+
+// TestPostgresRepositoryContract runs against a scratch database named by TEST_DATABASE_DSN; its tables are truncated.
+func TestPostgresRepositoryContract(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN not set")
+	}
+
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	defer db.Close()
+
+	runner, err := migrate.New(db, migrations.Postgres, migrate.Postgres, nil)
+	if err != nil {
+		t.Fatalf("migrate.New error: %v", err)
+	}
+	if _, err := runner.Up(context.Background()); err != nil {
+		t.Fatalf("migrate up error: %v", err)
+	}
+
+	storagetest.Run(t, func(t *testing.T) storagetest.Repository {
+		_, err := db.Exec(`TRUNCATE processed_articles, article_enrichments, article_versions, scan_outcomes,
+			reviews, articles, run_checkpoints, pipeline_runs RESTART IDENTITY CASCADE`)
+		if err != nil {
+			t.Fatalf("truncate: %v", err)
+		}
+		return NewPostgresRepository(db)
+	})
+}
+
+func TestLatestOutcomesKeepsLastPerKey(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2025, time.November, 11, 6, 0, 0, 0, time.UTC)
+	outcomes := latestOutcomes([]domain.ScanOutcome{
+		{Day: day, Site: "arxiv", Category: "cs.LG", Error: "503"},
+		{Day: day, Site: "arxiv", Articles: 4},
+		{Day: day.Add(time.Hour), Site: "arxiv", Category: "cs.LG", Articles: 2},
+		{Day: day.AddDate(0, 0, 1), Site: "arxiv", Category: "cs.LG"},
+	})
+	if len(outcomes) != 3 {
+		t.Fatalf("expected three distinct keys, got %+v", outcomes)
+	}
+	if outcomes[0].Category != "cs.LG" || outcomes[0].Failed() || outcomes[0].Articles != 2 {
+		t.Fatalf("expected the later cs.LG outcome in the first slot, got %+v", outcomes[0])
+	}
+	if outcomes[1].Category != "" || !outcomes[2].Day.Equal(day.AddDate(0, 0, 1)) {
+		t.Fatalf("unexpected order: %+v", outcomes)
+	}
+}
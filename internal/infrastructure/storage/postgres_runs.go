@@ -0,0 +1,220 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/ports"
+)
+
+var _ ports.RunStore = (*PostgresRepository)(nil)
+
+// StartRun inserts a new pipeline run and returns its ID.
+func (r *PostgresRepository) StartRun(ctx context.Context, run domain.PipelineRun) (int64, error) {
+	if r.db == nil {
+		return 0, nil
+	}
+
+	errs, err := jsonList(run.Errors)
+	if err != nil {
+		return 0, fmt.Errorf("encode run errors: %w", err)
+	}
+
+	query, args, err := psql.
+		Insert("pipeline_runs").
+		Columns("day", "timezone", "started_at", "status", "fetched", "ranked", "summarized", "delivered", "errors").
+		Values(run.Day, run.Day.Location().String(), run.StartedAt, string(run.Status),
+			run.Fetched, run.Ranked, run.Summarized, run.Delivered, errs).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build insert run: %w", err)
+	}
+
+	var id int64
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
+		return 0, fmt.Errorf("insert run: %w", err)
+	}
+	return id, nil
+}
+
+// UpdateRun stores the run's status, stage counts and errors.
+func (r *PostgresRepository) UpdateRun(ctx context.Context, run domain.PipelineRun) error {
+	if r.db == nil {
+		return nil
+	}
+
+	errs, err := jsonList(run.Errors)
+	if err != nil {
+		return fmt.Errorf("encode run errors: %w", err)
+	}
+
+	query, args, err := psql.
+		Update("pipeline_runs").
+		SetMap(map[string]interface{}{
+			"finished_at": sql.NullTime{Time: run.FinishedAt, Valid: !run.FinishedAt.IsZero()},
+			"status":      string(run.Status),
+			"fetched":     run.Fetched,
+			"ranked":      run.Ranked,
+			"summarized":  run.Summarized,
+			"delivered":   run.Delivered,
+			"errors":      errs,
+		}).
+		Where(sq.Eq{"id": run.ID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build update run: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("update run: %w", err)
+	}
+	return nil
+}
+
+// UnfinishedRuns returns running and failed runs, oldest first.
+func (r *PostgresRepository) UnfinishedRuns(ctx context.Context) ([]domain.PipelineRun, error) {
+	if r.db == nil {
+		return nil, nil
+	}
+
+	query, args, err := psql.
+		Select("id", "day", "timezone", "started_at", "finished_at", "status",
+			"fetched", "ranked", "summarized", "delivered", "errors").
+		From("pipeline_runs").
+		Where(sq.NotEq{"status": string(domain.RunSucceeded)}).
+		OrderBy("started_at", "id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build runs query: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query runs: %w", err)
+	}
+
+	var runs []domain.PipelineRun
+	for rows.Next() {
+		var (
+			run      domain.PipelineRun
+			timezone string
+			finished sql.NullTime
+			status   string
+			errs     []byte
+		)
+		if err := rows.Scan(&run.ID, &run.Day, &timezone, &run.StartedAt, &finished, &status,
+			&run.Fetched, &run.Ranked, &run.Summarized, &run.Delivered, &errs); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("scan run: %w", err)
+		}
+		if loc, err := time.LoadLocation(timezone); err == nil {
+			run.Day = run.Day.In(loc)
+		}
+		run.FinishedAt = finished.Time
+		run.Status = domain.RunStatus(status)
+		if err := json.Unmarshal(errs, &run.Errors); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("decode run errors: %w", err)
+		}
+		runs = append(runs, run)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		_ = rows.Close()
+		return nil, fmt.Errorf("rows iteration: %w", rowsErr)
+	}
+
+	if closeErr := rows.Close(); closeErr != nil {
+		return nil, fmt.Errorf("close rows: %w", closeErr)
+	}
+
+	return runs, nil
+}
+
+// SaveCheckpoint upserts the article's checkpoint within its run.
+func (r *PostgresRepository) SaveCheckpoint(ctx context.Context, checkpoint domain.Checkpoint) error {
+	if r.db == nil {
+		return nil
+	}
+
+	review, err := json.Marshal(checkpoint.Review)
+	if err != nil {
+		return fmt.Errorf("encode checkpoint review: %w", err)
+	}
+
+	query, args, err := psql.
+		Insert("run_checkpoints").
+		Columns("run_id", "external_id", "position", "stage", "review", "sent_to_chat", "updated_at").
+		Values(checkpoint.RunID, checkpoint.Review.Article.ID, checkpoint.Position, string(checkpoint.Stage),
+			review, checkpoint.SentToChat, checkpoint.UpdatedAt).
+		Suffix("ON CONFLICT (run_id, external_id) DO UPDATE SET position = EXCLUDED.position, stage = EXCLUDED.stage, " +
+			"review = EXCLUDED.review, sent_to_chat = EXCLUDED.sent_to_chat, updated_at = EXCLUDED.updated_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build upsert checkpoint: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("upsert checkpoint: %w", err)
+	}
+	return nil
+}
+
+// LoadCheckpoints returns the run's checkpoints in the order the articles were fetched.
+func (r *PostgresRepository) LoadCheckpoints(ctx context.Context, runID int64) ([]domain.Checkpoint, error) {
+	if r.db == nil {
+		return nil, nil
+	}
+
+	query, args, err := psql.
+		Select("position", "stage", "review", "sent_to_chat", "updated_at").
+		From("run_checkpoints").
+		Where(sq.Eq{"run_id": runID}).
+		OrderBy("position").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build checkpoints query: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query checkpoints: %w", err)
+	}
+
+	var checkpoints []domain.Checkpoint
+	for rows.Next() {
+		var (
+			checkpoint = domain.Checkpoint{RunID: runID}
+			stage      string
+			review     []byte
+		)
+		if err := rows.Scan(&checkpoint.Position, &stage, &review, &checkpoint.SentToChat, &checkpoint.UpdatedAt); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("scan checkpoint: %w", err)
+		}
+		checkpoint.Stage = domain.ProcessingStatus(stage)
+		if err := json.Unmarshal(review, &checkpoint.Review); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("decode checkpoint review: %w", err)
+		}
+		checkpoints = append(checkpoints, checkpoint)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		_ = rows.Close()
+		return nil, fmt.Errorf("rows iteration: %w", rowsErr)
+	}
+
+	if closeErr := rows.Close(); closeErr != nil {
+		return nil, fmt.Errorf("close rows: %w", closeErr)
+	}
+
+	return checkpoints, nil
+}
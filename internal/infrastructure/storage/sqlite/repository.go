@@ -0,0 +1,442 @@
+// Package sqlite stores processed articles in a local SQLite file for single-machine deployments.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	_ "modernc.org/sqlite"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/ports"
+)
+
+// pragmas put the database in WAL mode so readers do not block the writer, and wait instead of
+// failing with SQLITE_BUSY when the file is locked by another process.
+var pragmas = []string{"journal_mode(WAL)", "busy_timeout(5000)", "foreign_keys(1)", "synchronous(NORMAL)"}
+
+// Repository implements the storage ports on top of SQLite, mirroring the Postgres repository.
+type Repository struct {
+	db *sql.DB
+}
+
+var _ ports.ArticleRepository = (*Repository)(nil)
+var _ ports.EnrichmentCache = (*Repository)(nil)
+var _ ports.VersionTracker = (*Repository)(nil)
+var _ ports.ScanOutcomeStore = (*Repository)(nil)
+var _ ports.ArticleArchive = (*Repository)(nil)
+var _ ports.RunStore = (*Repository)(nil)
+
+// NewRepository wires a sql.DB opened with Open.
+func NewRepository(db *sql.DB) *Repository {
+	return &Repository{db: db}
+}
+
+var sqlb = sq.StatementBuilder.PlaceholderFormat(sq.Question)
+
+// Open opens the database file named by a sqlite://path DSN (sqlite:path and plain paths work too),
+// keeping any query parameters and adding the WAL pragmas. The pool is limited to one connection
+// because SQLite serialises writers anyway.
+func Open(dsn string) (*sql.DB, error) {
+	path := strings.TrimPrefix(strings.TrimPrefix(dsn, "sqlite://"), "sqlite:")
+	path, rawQuery, _ := strings.Cut(path, "?")
+	if path == "" {
+		return nil, errors.New("sqlite dsn has no file path")
+	}
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return nil, fmt.Errorf("parse sqlite dsn: %w", err)
+	}
+	for _, pragma := range pragmas {
+		query.Add("_pragma", pragma)
+	}
+	if query.Get("_time_format") == "" {
+		query.Set("_time_format", "sqlite")
+	}
+
+	db, err := sql.Open("sqlite", path+"?"+query.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("open sqlite: %w", err)
+	}
+	db.SetMaxOpenConns(1)
+	return db, nil
+}
+
+// AlreadyProcessed returns a map with IDs that were already delivered.
+func (r *Repository) AlreadyProcessed(ctx context.Context, ids []string) (map[string]bool, error) {
+	if len(ids) == 0 {
+		return map[string]bool{}, nil
+	}
+
+	query, args, err := sqlb.
+		Select("external_id").
+		From("processed_articles").
+		Where(sq.Eq{"external_id": ids, "status": string(domain.StatusDelivered)}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build processed query: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query processed: %w", err)
+	}
+
+	result := make(map[string]bool)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("scan id: %w", err)
+		}
+		result[id] = true
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		_ = rows.Close()
+		return nil, fmt.Errorf("rows iteration: %w", rowsErr)
+	}
+
+	if closeErr := rows.Close(); closeErr != nil {
+		return nil, fmt.Errorf("close rows: %w", closeErr)
+	}
+
+	return result, nil
+}
+
+// SaveProcessed upserts the processed article snapshot.
+func (r *Repository) SaveProcessed(ctx context.Context, article domain.ProcessedArticle) error {
+	authors, err := jsonList(article.Article.Authors)
+	if err != nil {
+		return fmt.Errorf("encode authors: %w", err)
+	}
+	categories, err := jsonList(article.Article.SecondaryCategories)
+	if err != nil {
+		return fmt.Errorf("encode categories: %w", err)
+	}
+
+	query, args, err := sqlb.
+		Insert("processed_articles").
+		Columns("external_id", "title", "summary", "score", "status",
+			"url", "authors", "primary_category", "secondary_categories", "comments", "journal_ref",
+			"version", "announcement").
+		Values(
+			article.Article.ID,
+			article.Article.Title,
+			article.Summary,
+			article.Score,
+			string(article.Status),
+			article.Article.URL,
+			authors,
+			article.Article.PrimaryCategory,
+			categories,
+			article.Article.Comments,
+			article.Article.JournalRef,
+			article.Article.Version,
+			string(article.Article.Announcement),
+		).
+		Suffix("ON CONFLICT (external_id) DO UPDATE SET summary = excluded.summary, score = excluded.score, status = excluded.status, " +
+			"url = excluded.url, authors = excluded.authors, primary_category = excluded.primary_category, " +
+			"secondary_categories = excluded.secondary_categories, comments = excluded.comments, journal_ref = excluded.journal_ref, " +
+			"version = excluded.version, announcement = excluded.announcement, updated_at = CURRENT_TIMESTAMP").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build upsert processed: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("upsert processed: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateStatus moves already saved articles to status.
+func (r *Repository) UpdateStatus(ctx context.Context, ids []string, status domain.ProcessingStatus) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query, args, err := sqlb.
+		Update("processed_articles").
+		Set("status", string(status)).
+		Set("updated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{"external_id": ids}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build update status: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("update status: %w", err)
+	}
+	return nil
+}
+
+// SaveArticle upserts the article metadata into the articles table.
+func (r *Repository) SaveArticle(ctx context.Context, article domain.Article) error {
+	authors, err := jsonList(article.Authors)
+	if err != nil {
+		return fmt.Errorf("encode authors: %w", err)
+	}
+	categories, err := jsonList(articleCategories(article))
+	if err != nil {
+		return fmt.Errorf("encode categories: %w", err)
+	}
+
+	query, args, err := sqlb.
+		Insert("articles").
+		Columns("external_id", "title", "abstract", "url", "source", "authors", "primary_category", "categories",
+			"doi", "venue", "version", "announcement", "published_at").
+		Values(
+			article.ID,
+			article.Title,
+			article.Abstract,
+			article.URL,
+			article.Source,
+			authors,
+			article.PrimaryCategory,
+			categories,
+			article.DOI,
+			article.Venue,
+			article.Version,
+			string(article.Announcement),
+			nullTime(article.PublishedAt),
+		).
+		Suffix("ON CONFLICT (external_id) DO UPDATE SET title = excluded.title, abstract = excluded.abstract, url = excluded.url, " +
+			"source = excluded.source, authors = excluded.authors, primary_category = excluded.primary_category, " +
+			"categories = excluded.categories, doi = excluded.doi, venue = excluded.venue, version = excluded.version, " +
+			"announcement = excluded.announcement, published_at = excluded.published_at, updated_at = CURRENT_TIMESTAMP").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build upsert article: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("upsert article: %w", err)
+	}
+	return nil
+}
+
+// SaveReview upserts the review of an already saved article, one row per model.
+func (r *Repository) SaveReview(ctx context.Context, review domain.ArticleReview) error {
+	topics, err := jsonList(review.Topics)
+	if err != nil {
+		return fmt.Errorf("encode topics: %w", err)
+	}
+	rankedAt := review.RankedAt
+	if rankedAt.IsZero() {
+		rankedAt = time.Now()
+	}
+
+	query, args, err := sqlb.
+		Insert("reviews").
+		Columns("external_id", "model", "score", "topics", "summary", "ranked_at").
+		Values(review.Article.ID, review.Model, review.Score, topics, review.Summary, rankedAt.UTC()).
+		Suffix("ON CONFLICT (external_id, model) DO UPDATE SET score = excluded.score, topics = excluded.topics, " +
+			"summary = excluded.summary, ranked_at = excluded.ranked_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build upsert review: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("upsert review: %w", err)
+	}
+	return nil
+}
+
+// RecordVersion stores the article version and returns the highest version seen before it.
+func (r *Repository) RecordVersion(ctx context.Context, articleID string, version int) (int, error) {
+	if version <= 0 {
+		return 0, nil
+	}
+
+	query, args, err := sqlb.
+		Select("COALESCE(MAX(version), 0)").
+		From("article_versions").
+		Where(sq.Eq{"external_id": articleID}).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build version query: %w", err)
+	}
+
+	var previous int
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&previous); err != nil {
+		return 0, fmt.Errorf("query version: %w", err)
+	}
+
+	insert, args, err := sqlb.
+		Insert("article_versions").
+		Columns("external_id", "version").
+		Values(articleID, version).
+		Suffix("ON CONFLICT (external_id, version) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build insert version: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, insert, args...); err != nil {
+		return 0, fmt.Errorf("insert version: %w", err)
+	}
+
+	return previous, nil
+}
+
+// SaveScanOutcomes upserts per-site and per-category outcomes keyed by the run's calendar day.
+func (r *Repository) SaveScanOutcomes(ctx context.Context, outcomes []domain.ScanOutcome) error {
+	if len(outcomes) == 0 {
+		return nil
+	}
+
+	insert := sqlb.
+		Insert("scan_outcomes").
+		Columns("run_day", "site", "category", "articles", "error", "scanned_at")
+	for _, outcome := range outcomes {
+		insert = insert.Values(
+			outcome.Day.Format("2006-01-02"),
+			outcome.Site,
+			outcome.Category,
+			outcome.Articles,
+			sql.NullString{String: outcome.Error, Valid: outcome.Error != ""},
+			outcome.ScannedAt.UTC(),
+		)
+	}
+	query, args, err := insert.
+		Suffix("ON CONFLICT (run_day, site, category) DO UPDATE SET articles = excluded.articles, " +
+			"error = excluded.error, scanned_at = excluded.scanned_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build upsert scan outcomes: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("upsert scan outcomes: %w", err)
+	}
+	return nil
+}
+
+// LoadScanOutcomes returns the outcomes stored for the day's calendar date.
+func (r *Repository) LoadScanOutcomes(ctx context.Context, day time.Time) ([]domain.ScanOutcome, error) {
+	query, args, err := sqlb.
+		Select("site", "category", "articles", "COALESCE(error, '')", "scanned_at").
+		From("scan_outcomes").
+		Where(sq.Eq{"run_day": day.Format("2006-01-02")}).
+		OrderBy("site", "category").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build scan outcomes query: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query scan outcomes: %w", err)
+	}
+
+	var outcomes []domain.ScanOutcome
+	for rows.Next() {
+		outcome := domain.ScanOutcome{Day: day}
+		if err := rows.Scan(&outcome.Site, &outcome.Category, &outcome.Articles, &outcome.Error, &outcome.ScannedAt); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("scan outcome: %w", err)
+		}
+		outcomes = append(outcomes, outcome)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		_ = rows.Close()
+		return nil, fmt.Errorf("rows iteration: %w", rowsErr)
+	}
+
+	if closeErr := rows.Close(); closeErr != nil {
+		return nil, fmt.Errorf("close rows: %w", closeErr)
+	}
+
+	return outcomes, nil
+}
+
+// LoadEnrichment returns the cached enrichment for an article or nil when absent.
+func (r *Repository) LoadEnrichment(ctx context.Context, articleID string) (*domain.Enrichment, error) {
+	query, args, err := sqlb.
+		Select("payload").
+		From("article_enrichments").
+		Where(sq.Eq{"external_id": articleID}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build enrichment query: %w", err)
+	}
+
+	var payload string
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&payload); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("query enrichment: %w", err)
+	}
+
+	var enrichment domain.Enrichment
+	if err := json.Unmarshal([]byte(payload), &enrichment); err != nil {
+		return nil, fmt.Errorf("decode enrichment: %w", err)
+	}
+	return &enrichment, nil
+}
+
+// SaveEnrichment upserts the enrichment snapshot for an article.
+func (r *Repository) SaveEnrichment(ctx context.Context, articleID string, enrichment domain.Enrichment) error {
+	payload, err := json.Marshal(enrichment)
+	if err != nil {
+		return fmt.Errorf("encode enrichment: %w", err)
+	}
+
+	query, args, err := sqlb.
+		Insert("article_enrichments").
+		Columns("external_id", "provider", "payload", "fetched_at").
+		Values(articleID, enrichment.Provider, string(payload), enrichment.FetchedAt.UTC()).
+		Suffix("ON CONFLICT (external_id) DO UPDATE SET provider = excluded.provider, payload = excluded.payload, fetched_at = excluded.fetched_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build upsert enrichment: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("upsert enrichment: %w", err)
+	}
+
+	return nil
+}
+
+// articleCategories lists the primary category first, followed by the secondary ones.
+func articleCategories(article domain.Article) []string {
+	categories := make([]string, 0, len(article.SecondaryCategories)+1)
+	if article.PrimaryCategory != "" {
+		categories = append(categories, article.PrimaryCategory)
+	}
+	for _, category := range article.SecondaryCategories {
+		if category != article.PrimaryCategory {
+			categories = append(categories, category)
+		}
+	}
+	return categories
+}
+
+// jsonList encodes list columns as JSON array text, never null.
+func jsonList(values []string) (string, error) {
+	if values == nil {
+		values = []string{}
+	}
+	raw, err := json.Marshal(values)
+	return string(raw), err
+}
+
+// nullTime stores zero times as NULL and others in UTC so text comparisons order correctly.
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t.UTC(), Valid: !t.IsZero()}
+}
@@ -0,0 +1,50 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"ArticlesScanner/internal/infrastructure/storage/migrate"
+	"ArticlesScanner/internal/infrastructure/storage/storagetest"
+	"ArticlesScanner/migrations"
+)
+
+func TestRepositoryContract(t *testing.T) {
+	t.Parallel()
+
+	storagetest.Run(t, func(t *testing.T) storagetest.Repository {
+		db, err := Open("sqlite://" + filepath.Join(t.TempDir(), "articles.db"))
+		if err != nil {
+			t.Fatalf("Open error: %v", err)
+		}
+		t.Cleanup(func() { _ = db.Close() })
+
+		runner, err := migrate.New(db, migrations.SQLite, migrate.SQLite, nil)
+		if err != nil {
+			t.Fatalf("migrate.New error: %v", err)
+		}
+		if _, err := runner.Up(context.Background()); err != nil {
+			t.Fatalf("migrate up error: %v", err)
+		}
+		return NewRepository(db)
+	})
+}
+
+func TestOpenUsesWAL(t *testing.T) {
+	t.Parallel()
+
+	db, err := Open("sqlite://" + filepath.Join(t.TempDir(), "wal.db") + "?_txlock=immediate")
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	defer db.Close()
+
+	var mode string
+	if err := db.QueryRow("PRAGMA journal_mode").Scan(&mode); err != nil {
+		t.Fatalf("journal_mode error: %v", err)
+	}
+	if mode != "wal" {
+		t.Fatalf("expected WAL journal mode, got %q", mode)
+	}
+}
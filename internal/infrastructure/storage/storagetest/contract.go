@@ -0,0 +1,252 @@
+// Package storagetest holds the behaviour every storage backend must share, run by each backend's tests.
+package storagetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/ports"
+)
+
+// Repository is the full set of storage ports a backend implements.
+type Repository interface {
+	ports.ArticleRepository
+	ports.VersionTracker
+	ports.EnrichmentCache
+	ports.ScanOutcomeStore
+	ports.ArticleArchive
+	ports.RunStore
+}
+
+// Run checks repo behaviour against the contract; open must return an empty, migrated repository
+// for each subtest.
+func Run(t *testing.T, open func(t *testing.T) Repository) {
+	t.Helper()
+
+	t.Run("AlreadyProcessedOnlyReportsDelivered", func(t *testing.T) {
+		ctx := context.Background()
+		repo := open(t)
+
+		for _, id := range []string{"a", "b"} {
+			article := domain.ProcessedArticle{
+				Article: domain.Article{ID: id, Title: "Title " + id, Authors: []string{"Ada"}},
+				Status:  domain.StatusSummarized,
+				Score:   0.5,
+			}
+			if err := repo.SaveProcessed(ctx, article); err != nil {
+				t.Fatalf("SaveProcessed error: %v", err)
+			}
+		}
+		if err := repo.UpdateStatus(ctx, []string{"a"}, domain.StatusDelivered); err != nil {
+			t.Fatalf("UpdateStatus error: %v", err)
+		}
+
+		processed, err := repo.AlreadyProcessed(ctx, []string{"a", "b", "c"})
+		if err != nil {
+			t.Fatalf("AlreadyProcessed error: %v", err)
+		}
+		if len(processed) != 1 || !processed["a"] {
+			t.Fatalf("expected only the delivered article, got %v", processed)
+		}
+
+		// A re-processed revision goes back to an earlier stage until it is delivered again.
+		if err := repo.SaveProcessed(ctx, domain.ProcessedArticle{Article: domain.Article{ID: "a", Title: "Title a", Version: 2}, Status: domain.StatusFetched}); err != nil {
+			t.Fatalf("SaveProcessed upsert error: %v", err)
+		}
+		if processed, err := repo.AlreadyProcessed(ctx, []string{"a"}); err != nil || len(processed) != 0 {
+			t.Fatalf("expected upsert to reset delivery, got %v, err %v", processed, err)
+		}
+	})
+
+	t.Run("RecordVersionReturnsPreviousHighest", func(t *testing.T) {
+		ctx := context.Background()
+		repo := open(t)
+
+		for _, step := range []struct{ version, previous int }{{1, 0}, {3, 1}, {2, 3}, {3, 3}} {
+			previous, err := repo.RecordVersion(ctx, "2511.00001", step.version)
+			if err != nil {
+				t.Fatalf("RecordVersion error: %v", err)
+			}
+			if previous != step.previous {
+				t.Fatalf("RecordVersion(%d) = %d, want %d", step.version, previous, step.previous)
+			}
+		}
+	})
+
+	t.Run("EnrichmentRoundTrip", func(t *testing.T) {
+		ctx := context.Background()
+		repo := open(t)
+
+		if cached, err := repo.LoadEnrichment(ctx, "missing"); err != nil || cached != nil {
+			t.Fatalf("expected nil for unknown article, got %+v, err %v", cached, err)
+		}
+
+		fetched := time.Date(2025, time.November, 10, 8, 30, 0, 0, time.UTC)
+		for _, citations := range []int{3, 7} {
+			enrichment := domain.Enrichment{
+				Provider:      "openalex",
+				CitationCount: citations,
+				Authors:       []domain.AuthorMetrics{{Name: "Ada", HIndex: 12}},
+				FetchedAt:     fetched,
+			}
+			if err := repo.SaveEnrichment(ctx, "a", enrichment); err != nil {
+				t.Fatalf("SaveEnrichment error: %v", err)
+			}
+		}
+
+		cached, err := repo.LoadEnrichment(ctx, "a")
+		if err != nil || cached == nil {
+			t.Fatalf("LoadEnrichment = %+v, err %v", cached, err)
+		}
+		if cached.CitationCount != 7 || cached.MaxAuthorHIndex() != 12 || !cached.FetchedAt.Equal(fetched) {
+			t.Fatalf("unexpected enrichment: %+v", cached)
+		}
+	})
+
+	t.Run("ScanOutcomesPerDay", func(t *testing.T) {
+		ctx := context.Background()
+		repo := open(t)
+
+		day := time.Date(2025, time.November, 11, 6, 0, 0, 0, time.UTC)
+		scanned := day.Add(time.Minute)
+		outcomes := []domain.ScanOutcome{
+			{Day: day, Site: "arxiv", Articles: 4, ScannedAt: scanned},
+			{Day: day, Site: "arxiv", Category: "cs.LG", Error: "503", ScannedAt: scanned},
+			{Day: day.AddDate(0, 0, 1), Site: "arxiv", ScannedAt: scanned},
+		}
+		if err := repo.SaveScanOutcomes(ctx, outcomes); err != nil {
+			t.Fatalf("SaveScanOutcomes error: %v", err)
+		}
+		// A batch may report the same category twice; the later entry wins.
+		retried := []domain.ScanOutcome{
+			{Day: day, Site: "arxiv", Category: "cs.LG", Error: "timeout", ScannedAt: scanned},
+			{Day: day, Site: "arxiv", Category: "cs.LG", Articles: 2, ScannedAt: scanned},
+		}
+		if err := repo.SaveScanOutcomes(ctx, retried); err != nil {
+			t.Fatalf("SaveScanOutcomes upsert error: %v", err)
+		}
+
+		loaded, err := repo.LoadScanOutcomes(ctx, day)
+		if err != nil {
+			t.Fatalf("LoadScanOutcomes error: %v", err)
+		}
+		if len(loaded) != 2 || loaded[0].Category != "" || loaded[1].Category != "cs.LG" {
+			t.Fatalf("unexpected outcomes: %+v", loaded)
+		}
+		if loaded[1].Failed() || loaded[1].Articles != 2 || !loaded[1].ScannedAt.Equal(scanned) {
+			t.Fatalf("expected the retried category to be replaced, got %+v", loaded[1])
+		}
+	})
+
+	t.Run("ArchiveArticlesAndReviews", func(t *testing.T) {
+		ctx := context.Background()
+		repo := open(t)
+
+		article := domain.Article{
+			ID:                  "2511.00001",
+			Title:               "Attention",
+			Source:              "arxiv-ai",
+			Authors:             []string{"Ada", "Alan"},
+			PrimaryCategory:     "cs.AI",
+			SecondaryCategories: []string{"cs.LG"},
+			PublishedAt:         time.Date(2025, time.November, 10, 0, 0, 0, 0, time.UTC),
+		}
+		if err := repo.SaveArticle(ctx, article); err != nil {
+			t.Fatalf("SaveArticle error: %v", err)
+		}
+		article.Title = "Attention, revised"
+		if err := repo.SaveArticle(ctx, article); err != nil {
+			t.Fatalf("SaveArticle upsert error: %v", err)
+		}
+
+		for _, review := range []domain.ArticleReview{
+			{Article: article, Model: "ranker-v1", Score: 0.4, Topics: []string{"nlp"}},
+			{Article: article, Model: "ranker-v1", Score: 0.8, Topics: []string{"nlp"}},
+			{Article: article, Model: "ranker-v2", Score: 0.6},
+		} {
+			if err := repo.SaveReview(ctx, review); err != nil {
+				t.Fatalf("SaveReview error: %v", err)
+			}
+		}
+	})
+
+	t.Run("RunsAndCheckpoints", func(t *testing.T) {
+		ctx := context.Background()
+		repo := open(t)
+
+		moscow, err := time.LoadLocation("Europe/Moscow")
+		if err != nil {
+			t.Skipf("timezone data unavailable: %v", err)
+		}
+		day := time.Date(2025, time.November, 11, 6, 0, 0, 0, moscow)
+		started := time.Date(2025, time.November, 11, 3, 0, 5, 0, time.UTC)
+
+		first := domain.PipelineRun{Day: day, StartedAt: started, Status: domain.RunRunning}
+		id, err := repo.StartRun(ctx, first)
+		if err != nil {
+			t.Fatalf("StartRun error: %v", err)
+		}
+		second := domain.PipelineRun{Day: day.AddDate(0, 0, 1), StartedAt: started.AddDate(0, 0, 1), Status: domain.RunRunning}
+		secondID, err := repo.StartRun(ctx, second)
+		if err != nil || secondID == id {
+			t.Fatalf("StartRun second = %d, err %v", secondID, err)
+		}
+
+		first.ID = id
+		first.Status = domain.RunFailed
+		first.Fetched, first.Ranked = 2, 1
+		first.Errors = []string{"rank article b: timeout"}
+		first.FinishedAt = started.Add(time.Minute)
+		if err := repo.UpdateRun(ctx, first); err != nil {
+			t.Fatalf("UpdateRun error: %v", err)
+		}
+		second.ID = secondID
+		second.Status = domain.RunSucceeded
+		second.FinishedAt = started.AddDate(0, 0, 1).Add(time.Minute)
+		if err := repo.UpdateRun(ctx, second); err != nil {
+			t.Fatalf("UpdateRun second error: %v", err)
+		}
+
+		runs, err := repo.UnfinishedRuns(ctx)
+		if err != nil {
+			t.Fatalf("UnfinishedRuns error: %v", err)
+		}
+		if len(runs) != 1 {
+			t.Fatalf("expected one unfinished run, got %+v", runs)
+		}
+		run := runs[0]
+		if run.ID != id || run.Status != domain.RunFailed || run.Fetched != 2 || run.Ranked != 1 || len(run.Errors) != 1 {
+			t.Fatalf("unexpected run: %+v", run)
+		}
+		if !run.Day.Equal(day) || run.Day.Location().String() != "Europe/Moscow" || !run.FinishedAt.Equal(first.FinishedAt) {
+			t.Fatalf("expected run day in its original location, got %v (finished %v)", run.Day, run.FinishedAt)
+		}
+
+		updated := started.Add(30 * time.Second)
+		checkpoints := []domain.Checkpoint{
+			{RunID: id, Position: 1, Stage: domain.StatusFetched, Review: domain.ArticleReview{Article: domain.Article{ID: "b"}}, UpdatedAt: updated},
+			{RunID: id, Position: 0, Stage: domain.StatusRanked, Review: domain.ArticleReview{Article: domain.Article{ID: "a"}, Score: 0.7}, UpdatedAt: updated},
+			{RunID: id, Position: 0, Stage: domain.StatusSummarized, SentToChat: true, UpdatedAt: updated,
+				Review: domain.ArticleReview{Article: domain.Article{ID: "a"}, Score: 0.7, Summary: "short"}},
+			{RunID: secondID, Position: 0, Stage: domain.StatusDelivered, Review: domain.ArticleReview{Article: domain.Article{ID: "c"}}, UpdatedAt: updated},
+		}
+		for _, checkpoint := range checkpoints {
+			if err := repo.SaveCheckpoint(ctx, checkpoint); err != nil {
+				t.Fatalf("SaveCheckpoint error: %v", err)
+			}
+		}
+
+		loaded, err := repo.LoadCheckpoints(ctx, id)
+		if err != nil {
+			t.Fatalf("LoadCheckpoints error: %v", err)
+		}
+		if len(loaded) != 2 || loaded[0].Review.Article.ID != "a" || loaded[1].Review.Article.ID != "b" {
+			t.Fatalf("expected checkpoints in fetch order, got %+v", loaded)
+		}
+		if got := loaded[0]; got.Stage != domain.StatusSummarized || !got.SentToChat || got.Review.Summary != "short" || got.Review.Score != 0.7 || !got.UpdatedAt.Equal(updated) {
+			t.Fatalf("expected the latest checkpoint of a, got %+v", got)
+		}
+	})
+}
@@ -20,12 +20,15 @@ type Notifier struct {
 
 var _ ports.Notifier = (*Notifier)(nil)
 
-// NewNotifier registers bot token and chat identifier.
-func NewNotifier(botToken, chatID string) *Notifier {
+// NewNotifier registers bot token and chat identifier; a nil client falls back to a plain one.
+func NewNotifier(botToken, chatID string, client *http.Client) *Notifier {
+	if client == nil {
+		client = &http.Client{Timeout: 5 * time.Second}
+	}
 	return &Notifier{
 		botToken: botToken,
 		chatID:   chatID,
-		client:   &http.Client{Timeout: 5 * time.Second},
+		client:   client,
 	}
 }
 
@@ -0,0 +1,107 @@
+package transport
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"ArticlesScanner/internal/config"
+)
+
+// hostLimiters keeps one token bucket per host; hosts without an explicit limit share the default settings.
+type hostLimiters struct {
+	mu       sync.Mutex
+	fallback config.RateLimitConfig
+	limits   map[string]config.RateLimitConfig
+	buckets  map[string]*bucket
+}
+
+func newHostLimiters(fallback config.RateLimitConfig, limits map[string]config.RateLimitConfig) *hostLimiters {
+	normalized := make(map[string]config.RateLimitConfig, len(limits))
+	for host, limit := range limits {
+		normalized[strings.ToLower(strings.TrimSpace(host))] = limit
+	}
+	return &hostLimiters{fallback: fallback, limits: normalized, buckets: map[string]*bucket{}}
+}
+
+// wait blocks until the host's bucket grants a token or ctx ends.
+func (h *hostLimiters) wait(ctx context.Context, host string) error {
+	delay := h.bucket(host).reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+	return sleep(ctx, delay)
+}
+
+// atMost slows the host down to one request per interval (robots.txt Crawl-delay) if it is faster now.
+func (h *hostLimiters) atMost(host string, interval time.Duration) {
+	h.bucket(host).slowTo(1 / interval.Seconds())
+}
+
+func (h *hostLimiters) bucket(host string) *bucket {
+	host = strings.ToLower(host)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if b, ok := h.buckets[host]; ok {
+		return b
+	}
+	limit, ok := h.limits[host]
+	if !ok {
+		limit = h.fallback
+	}
+	b := newBucket(limit.RequestsPerSecond, limit.Burst)
+	h.buckets[host] = b
+	return b
+}
+
+// bucket is a token bucket; a non-positive rate means unlimited.
+type bucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newBucket(rate float64, burst int) *bucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &bucket{rate: rate, burst: float64(burst), tokens: float64(burst)}
+}
+
+// reserve takes a token and returns how long the caller must wait for it.
+func (b *bucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.rate <= 0 {
+		return 0
+	}
+
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+func (b *bucket) slowTo(rate float64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.rate <= 0 || rate < b.rate {
+		b.rate = rate
+		b.burst = 1
+		if b.tokens > 1 {
+			b.tokens = 1
+		}
+	}
+}
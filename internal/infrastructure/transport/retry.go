@@ -0,0 +1,63 @@
+package transport
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// retryPolicy computes exponential backoff, deferring to Retry-After when the server sends one.
+type retryPolicy struct {
+	maxRetries     int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+// delay returns how long to wait before the next attempt; ok is false when the server asks
+// for a pause longer than maxBackoff, in which case the response is handed back as is.
+func (p retryPolicy) delay(attempt int, resp *http.Response, now time.Time) (time.Duration, bool) {
+	backoff := p.initialBackoff
+	for i := 0; i < attempt && backoff < p.maxBackoff; i++ {
+		backoff *= 2
+	}
+	if p.maxBackoff > 0 && backoff > p.maxBackoff {
+		backoff = p.maxBackoff
+	}
+
+	if resp == nil {
+		return backoff, true
+	}
+	retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), now)
+	if !ok {
+		return backoff, true
+	}
+	if p.maxBackoff > 0 && retryAfter > p.maxBackoff {
+		return 0, false
+	}
+	if retryAfter > backoff {
+		return retryAfter, true
+	}
+	return backoff, true
+}
+
+// parseRetryAfter reads both delta-seconds and HTTP-date forms.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if wait := at.Sub(now); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
@@ -0,0 +1,226 @@
+package transport
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRobotsTTL = 24 * time.Hour
+	// robotsErrorTTL keeps unreachable robots.txt files from being refetched on every request.
+	robotsErrorTTL = 5 * time.Minute
+	maxRobotsBytes = 512 << 10
+)
+
+type robotsFetcher func(ctx context.Context, target string) (*http.Response, error)
+
+// robotsCache keeps parsed robots.txt rules per scheme and host.
+type robotsCache struct {
+	mu      sync.Mutex
+	agent   string
+	ttl     time.Duration
+	exempt  map[string]struct{}
+	entries map[string]robotsEntry
+}
+
+type robotsEntry struct {
+	rules     robotsRules
+	expiresAt time.Time
+}
+
+func newRobotsCache(userAgent string, ttl time.Duration, exemptHosts []string) *robotsCache {
+	if ttl <= 0 {
+		ttl = defaultRobotsTTL
+	}
+	exempt := make(map[string]struct{}, len(exemptHosts))
+	for _, host := range exemptHosts {
+		exempt[strings.ToLower(strings.TrimSpace(host))] = struct{}{}
+	}
+	return &robotsCache{
+		agent:   productToken(userAgent),
+		ttl:     ttl,
+		exempt:  exempt,
+		entries: map[string]robotsEntry{},
+	}
+}
+
+// allowed reports whether the URL may be fetched, loading robots.txt for its host on first use.
+func (c *robotsCache) allowed(ctx context.Context, fetch robotsFetcher, target *url.URL) bool {
+	if target.Path == "/robots.txt" {
+		return true
+	}
+	if _, ok := c.exempt[strings.ToLower(target.Hostname())]; ok {
+		return true
+	}
+	return c.rules(ctx, fetch, target).allows(requestPath(target))
+}
+
+// crawlDelay returns the Crawl-delay of already loaded rules for the URL's host.
+func (c *robotsCache) crawlDelay(target *url.URL) time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.entries[target.Scheme+"://"+target.Host].rules.crawlDelay
+}
+
+func (c *robotsCache) rules(ctx context.Context, fetch robotsFetcher, target *url.URL) robotsRules {
+	key := target.Scheme + "://" + target.Host
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.rules
+	}
+
+	rules, ttl := c.load(ctx, fetch, key+"/robots.txt")
+
+	c.mu.Lock()
+	c.entries[key] = robotsEntry{rules: rules, expiresAt: time.Now().Add(ttl)}
+	c.mu.Unlock()
+	return rules
+}
+
+// load fetches robots.txt; a missing file allows everything, and so does an unreachable one
+// for a short time so a flaky host does not block the run.
+func (c *robotsCache) load(ctx context.Context, fetch robotsFetcher, target string) (robotsRules, time.Duration) {
+	resp, err := fetch(ctx, target)
+	if err != nil {
+		return robotsRules{}, robotsErrorTTL
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return parseRobots(io.LimitReader(resp.Body, maxRobotsBytes), c.agent), c.ttl
+	case resp.StatusCode >= 400 && resp.StatusCode < 500:
+		return robotsRules{}, c.ttl
+	default:
+		return robotsRules{}, robotsErrorTTL
+	}
+}
+
+// robotsRules is the group of Allow/Disallow rules that applies to our agent.
+type robotsRules struct {
+	rules      []robotsRule
+	crawlDelay time.Duration
+}
+
+type robotsRule struct {
+	pattern string
+	allow   bool
+}
+
+// allows applies the longest matching rule; ties go to Allow (RFC 9309).
+func (r robotsRules) allows(path string) bool {
+	best, allowed := -1, true
+	for _, rule := range r.rules {
+		if !matchRobotsPattern(rule.pattern, path) {
+			continue
+		}
+		if len(rule.pattern) > best || (len(rule.pattern) == best && rule.allow) {
+			best, allowed = len(rule.pattern), rule.allow
+		}
+	}
+	return allowed
+}
+
+// parseRobots keeps the groups naming our product token, falling back to "*" groups.
+func parseRobots(r io.Reader, agent string) robotsRules {
+	var (
+		specific, wildcard robotsRules
+		matchSpecific      bool
+		matchWildcard      bool
+		inAgents           bool
+		hasSpecific        bool
+	)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		if key == "user-agent" {
+			if !inAgents {
+				matchSpecific, matchWildcard = false, false
+				inAgents = true
+			}
+			name := strings.ToLower(value)
+			switch {
+			case name == "*":
+				matchWildcard = true
+			case agent != "" && name == agent:
+				matchSpecific, hasSpecific = true, true
+			}
+			continue
+		}
+		inAgents = false
+
+		for _, group := range []struct {
+			match bool
+			rules *robotsRules
+		}{{matchSpecific, &specific}, {matchWildcard, &wildcard}} {
+			if !group.match {
+				continue
+			}
+			switch key {
+			case "allow", "disallow":
+				if value != "" {
+					group.rules.rules = append(group.rules.rules, robotsRule{pattern: value, allow: key == "allow"})
+				}
+			case "crawl-delay":
+				if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds > 0 {
+					group.rules.crawlDelay = time.Duration(seconds * float64(time.Second))
+				}
+			}
+		}
+	}
+
+	if hasSpecific {
+		return specific
+	}
+	return wildcard
+}
+
+// matchRobotsPattern supports the "*" wildcard and "$" end anchor.
+func matchRobotsPattern(pattern, path string) bool {
+	expr := "^" + strings.ReplaceAll(regexp.QuoteMeta(strings.TrimSuffix(pattern, "$")), `\*`, ".*")
+	if strings.HasSuffix(pattern, "$") {
+		expr += "$"
+	}
+	matched, err := regexp.MatchString(expr, path)
+	return err == nil && matched
+}
+
+func requestPath(target *url.URL) string {
+	path := target.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	if target.RawQuery != "" {
+		path += "?" + target.RawQuery
+	}
+	return path
+}
+
+// productToken turns "ArticlesScanner/1.0 (mailto:…)" into "articlesscanner".
+func productToken(userAgent string) string {
+	token, _, _ := strings.Cut(strings.TrimSpace(userAgent), "/")
+	token, _, _ = strings.Cut(token, " ")
+	return strings.ToLower(token)
+}
@@ -0,0 +1,213 @@
+// Package transport provides the shared outbound HTTP layer: per-host rate limits, robots.txt
+// compliance for scanners, Retry-After aware retries of idempotent requests and a stable User-Agent.
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"ArticlesScanner/internal/config"
+)
+
+const defaultUserAgent = "ArticlesScanner/1.0"
+
+// DisallowedError reports a request blocked by the host's robots.txt.
+type DisallowedError struct {
+	URL string
+}
+
+func (e *DisallowedError) Error() string {
+	return fmt.Sprintf("robots.txt disallows %s", e.URL)
+}
+
+// Transport is an http.RoundTripper shared by all adapters so limits apply per host across them.
+type Transport struct {
+	base        http.RoundTripper
+	userAgent   string
+	limiters    *hostLimiters
+	robots      *robotsCache
+	checkRobots bool
+	retry       retryPolicy
+	logger      *slog.Logger
+}
+
+var _ http.RoundTripper = (*Transport)(nil)
+
+// New builds the transport from configuration; base defaults to http.DefaultTransport.
+func New(cfg config.HTTPConfig, base http.RoundTripper, log *slog.Logger) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	t := &Transport{
+		base:      base,
+		userAgent: buildUserAgent(cfg.UserAgent, cfg.ContactEmail),
+		limiters:  newHostLimiters(cfg.DefaultLimit, cfg.RateLimits),
+		retry: retryPolicy{
+			maxRetries:     cfg.MaxRetries,
+			initialBackoff: cfg.InitialBackoff,
+			maxBackoff:     cfg.MaxBackoff,
+		},
+		logger: log,
+	}
+	if !cfg.IgnoreRobots {
+		t.robots = newRobotsCache(t.userAgent, cfg.RobotsTTL, cfg.RobotsExemptHosts)
+	}
+	return t
+}
+
+// ForScanners returns a transport sharing rate limits that also enforces robots.txt.
+func (t *Transport) ForScanners() *Transport {
+	clone := *t
+	clone.checkRobots = t.robots != nil
+	return &clone
+}
+
+// Client wraps the transport; timeout bounds the whole call including rate-limit waits and retries.
+func (t *Transport) Client(timeout time.Duration) *http.Client {
+	return &http.Client{Timeout: timeout, Transport: t}
+}
+
+// UserAgent returns the header value sent with every request.
+func (t *Transport) UserAgent() string {
+	return t.userAgent
+}
+
+// RoundTrip applies User-Agent, robots.txt, per-host limits and retries around the base transport.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	req = req.Clone(ctx)
+	req.Header.Set("User-Agent", t.userAgent)
+
+	if t.checkRobots {
+		if !t.robots.allowed(ctx, t.fetchRobots, req.URL) {
+			closeRequestBody(req)
+			return nil, &DisallowedError{URL: req.URL.String()}
+		}
+		if delay := t.robots.crawlDelay(req.URL); delay > 0 {
+			t.limiters.atMost(req.URL.Hostname(), delay)
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := t.limiters.wait(ctx, req.URL.Hostname()); err != nil {
+			closeRequestBody(req)
+			return nil, err
+		}
+
+		resp, err := t.base.RoundTrip(req)
+		if attempt >= t.retry.maxRetries || !retryable(ctx, req.Method, resp, err) {
+			return resp, err
+		}
+
+		delay, ok := t.retry.delay(attempt, resp, time.Now())
+		if !ok || (req.Body != nil && req.GetBody == nil) {
+			return resp, err
+		}
+		t.warn("retrying request", "url", req.URL.String(), "attempt", attempt+1, "delay", delay, "status", status(resp), "error", err)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+			_ = resp.Body.Close()
+		}
+
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return nil, fmt.Errorf("rewind request body: %w", bodyErr)
+			}
+			req.Body = body
+		}
+	}
+}
+
+// fetchRobots downloads robots.txt through the rate limiter but without robots checks or retries.
+func (t *Transport) fetchRobots(ctx context.Context, target string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, fmt.Errorf("build robots request: %w", err)
+	}
+	req.Header.Set("User-Agent", t.userAgent)
+	if err := t.limiters.wait(ctx, req.URL.Hostname()); err != nil {
+		return nil, err
+	}
+	return t.base.RoundTrip(req)
+}
+
+// buildUserAgent appends the contact address the way arXiv and Crossref ask crawlers to identify.
+func buildUserAgent(agent, email string) string {
+	agent = strings.TrimSpace(agent)
+	if agent == "" {
+		agent = defaultUserAgent
+	}
+	if email = strings.TrimSpace(email); email != "" {
+		agent = fmt.Sprintf("%s (mailto:%s)", agent, email)
+	}
+	return agent
+}
+
+// retryable reports whether another attempt is safe. Idempotent requests retry on network errors and
+// overload statuses; others, such as a Telegram sendMessage POST, only on a 429 with Retry-After,
+// where the server states it did not act on the request.
+func retryable(ctx context.Context, method string, resp *http.Response, err error) bool {
+	if !idempotent(method) {
+		return err == nil && resp.StatusCode == http.StatusTooManyRequests && resp.Header.Get("Retry-After") != ""
+	}
+	if err != nil {
+		return ctx.Err() == nil && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func idempotent(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func closeRequestBody(req *http.Request) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+}
+
+func status(resp *http.Response) string {
+	if resp == nil {
+		return ""
+	}
+	return resp.Status
+}
+
+func (t *Transport) warn(msg string, args ...interface{}) {
+	if t.logger != nil {
+		t.logger.Warn(msg, args...)
+	}
+}
@@ -0,0 +1,221 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"ArticlesScanner/internal/config"
+)
+
+func TestTransportRetriesWithRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("User-Agent"); got != "Scanner/2.0 (mailto:ops@example.org)" {
+			t.Errorf("unexpected user agent: %q", got)
+		}
+		if calls.Add(1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	tr := New(config.HTTPConfig{
+		UserAgent:      "Scanner/2.0",
+		ContactEmail:   "ops@example.org",
+		IgnoreRobots:   true,
+		MaxRetries:     3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     10 * time.Millisecond,
+	}, nil, nil)
+
+	resp, err := tr.Client(time.Second).Get(server.URL + "/list")
+	if err != nil {
+		t.Fatalf("Get error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || calls.Load() != 3 {
+		t.Fatalf("expected success on third attempt, got %s after %d calls", resp.Status, calls.Load())
+	}
+}
+
+func TestTransportGivesUpOnLongRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	tr := New(config.HTTPConfig{IgnoreRobots: true, MaxRetries: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Second}, nil, nil)
+	resp, err := tr.Client(time.Second).Get(server.URL)
+	if err != nil {
+		t.Fatalf("Get error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable || calls.Load() != 1 {
+		t.Fatalf("expected immediate 503, got %s after %d calls", resp.Status, calls.Load())
+	}
+}
+
+func TestTransportRetriesPostBodyOnlyOnRateLimit(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := make([]byte, 16)
+		n, _ := r.Body.Read(body)
+		if string(body[:n]) != "payload" {
+			t.Errorf("attempt %d lost body: %q", calls.Load()+1, body[:n])
+		}
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer server.Close()
+
+	tr := New(config.HTTPConfig{IgnoreRobots: true, MaxRetries: 1, InitialBackoff: time.Millisecond}, nil, nil)
+	resp, err := tr.Client(time.Second).Post(server.URL, "text/plain", strings.NewReader("payload"))
+	if err != nil {
+		t.Fatalf("Post error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || calls.Load() != 2 {
+		t.Fatalf("expected rate-limited POST to be retried, got %s after %d calls", resp.Status, calls.Load())
+	}
+}
+
+func TestTransportSendsFailedPostOnce(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	tr := New(config.HTTPConfig{IgnoreRobots: true, MaxRetries: 3, InitialBackoff: time.Millisecond}, nil, nil)
+	resp, err := tr.Client(time.Second).Post(server.URL, "application/json", strings.NewReader(`{"text":"digest"}`))
+	if err != nil {
+		t.Fatalf("Post error: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable || calls.Load() != 1 {
+		t.Fatalf("a POST that may have been processed must not be resent, got %s after %d calls", resp.Status, calls.Load())
+	}
+}
+
+func TestTransportEnforcesRobots(t *testing.T) {
+	t.Parallel()
+
+	var robotsCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			robotsCalls.Add(1)
+			_, _ = w.Write([]byte("User-agent: *\nDisallow: /private\n\nUser-agent: ArticlesScanner\nDisallow: /search\nAllow: /search/public\n"))
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	tr := New(config.HTTPConfig{}, nil, nil)
+	scanClient := tr.ForScanners().Client(time.Second)
+
+	var disallowed *DisallowedError
+	if _, err := scanClient.Get(server.URL + "/search?q=x"); !errors.As(err, &disallowed) {
+		t.Fatalf("expected DisallowedError, got %v", err)
+	}
+	for _, path := range []string{"/search/public", "/private"} {
+		resp, err := scanClient.Get(server.URL + path)
+		if err != nil {
+			t.Fatalf("Get %s error: %v", path, err)
+		}
+		_ = resp.Body.Close()
+	}
+	if robotsCalls.Load() != 1 {
+		t.Fatalf("expected robots.txt to be cached, fetched %d times", robotsCalls.Load())
+	}
+
+	resp, err := tr.Client(time.Second).Get(server.URL + "/search")
+	if err != nil {
+		t.Fatalf("API client should skip robots.txt: %v", err)
+	}
+	_ = resp.Body.Close()
+}
+
+func TestBucketSpacesRequests(t *testing.T) {
+	t.Parallel()
+
+	b := newBucket(2, 1)
+	now := time.Date(2025, time.November, 10, 0, 0, 0, 0, time.UTC)
+	if wait := b.reserve(now); wait != 0 {
+		t.Fatalf("first request should pass, waited %v", wait)
+	}
+	if wait := b.reserve(now); wait != 500*time.Millisecond {
+		t.Fatalf("expected 500ms wait, got %v", wait)
+	}
+	if wait := b.reserve(now.Add(2 * time.Second)); wait != 0 {
+		t.Fatalf("refilled bucket should pass, waited %v", wait)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.November, 10, 12, 0, 0, 0, time.UTC)
+	if d, ok := parseRetryAfter("120", now); !ok || d != 2*time.Minute {
+		t.Fatalf("seconds form: %v %v", d, ok)
+	}
+	if d, ok := parseRetryAfter("Mon, 10 Nov 2025 12:00:30 GMT", now); !ok || d != 30*time.Second {
+		t.Fatalf("date form: %v %v", d, ok)
+	}
+	if _, ok := parseRetryAfter("soon", now); ok {
+		t.Fatal("expected garbage to be ignored")
+	}
+}
+
+func TestMatchRobotsPattern(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		pattern, path string
+		want          bool
+	}{
+		{"/list", "/list/cs.AI/new", true},
+		{"/*.pdf$", "/pdf/2511.00001.pdf", true},
+		{"/*.pdf$", "/pdf/2511.00001.pdf?x=1", false},
+		{"/abs/*/v", "/abs/2511/v2", true},
+		{"/abs", "/list", false},
+	}
+	for _, tc := range cases {
+		if got := matchRobotsPattern(tc.pattern, tc.path); got != tc.want {
+			t.Errorf("match(%q, %q) = %v, want %v", tc.pattern, tc.path, got, tc.want)
+		}
+	}
+}
+
+func TestRetryableSkipsCancelledContext(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if retryable(ctx, http.MethodGet, nil, context.Canceled) {
+		t.Fatal("cancelled requests must not be retried")
+	}
+}
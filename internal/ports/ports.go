@@ -13,10 +13,59 @@ type ArticleSource interface {
 	FetchDaily(ctx context.Context, day time.Time) ([]domain.Article, error)
 }
 
+// ScanCommitter is implemented by sources that remember which sources were already scanned for a
+// day. FetchDaily only stages that record; the pipeline calls CommitScan once the fetched articles
+// are safely stored, so a failure in between rescans the same sources instead of losing them.
+type ScanCommitter interface {
+	CommitScan(ctx context.Context, day time.Time) error
+}
+
 // ArticleRepository persists processed articles for deduplication/history.
+// AlreadyProcessed reports only delivered articles, so anything stuck at an earlier stage is retried.
 type ArticleRepository interface {
 	AlreadyProcessed(ctx context.Context, ids []string) (map[string]bool, error)
 	SaveProcessed(ctx context.Context, article domain.ProcessedArticle) error
+	UpdateStatus(ctx context.Context, ids []string, status domain.ProcessingStatus) error
+}
+
+// ArticleArchive keeps the full article metadata and every model review for later analysis.
+type ArticleArchive interface {
+	SaveArticle(ctx context.Context, article domain.Article) error
+	SaveReview(ctx context.Context, review domain.ArticleReview) error
+}
+
+// RunStore records pipeline runs and per-article checkpoints so interrupted runs can be resumed.
+// UnfinishedRuns returns runs that did not succeed, oldest first.
+type RunStore interface {
+	StartRun(ctx context.Context, run domain.PipelineRun) (int64, error)
+	UpdateRun(ctx context.Context, run domain.PipelineRun) error
+	UnfinishedRuns(ctx context.Context) ([]domain.PipelineRun, error)
+	SaveCheckpoint(ctx context.Context, checkpoint domain.Checkpoint) error
+	LoadCheckpoints(ctx context.Context, runID int64) ([]domain.Checkpoint, error)
+}
+
+// ScanOutcomeStore keeps per-site scan outcomes so a re-run of the same day retries only failed sources.
+type ScanOutcomeStore interface {
+	SaveScanOutcomes(ctx context.Context, outcomes []domain.ScanOutcome) error
+	LoadScanOutcomes(ctx context.Context, day time.Time) ([]domain.ScanOutcome, error)
+}
+
+// VersionTracker remembers which version of an article was last seen.
+// RecordVersion stores version and returns the highest version recorded before (0 when unknown).
+type VersionTracker interface {
+	RecordVersion(ctx context.Context, articleID string, version int) (int, error)
+}
+
+// Enricher looks up bibliometric metadata (citations, venue, h-index) for an article.
+// A nil result without error means the provider does not know the article.
+type Enricher interface {
+	Enrich(ctx context.Context, article domain.Article) (*domain.Enrichment, error)
+}
+
+// EnrichmentCache stores enrichment lookups so repeated runs skip external APIs.
+type EnrichmentCache interface {
+	LoadEnrichment(ctx context.Context, articleID string) (*domain.Enrichment, error)
+	SaveEnrichment(ctx context.Context, articleID string, enrichment domain.Enrichment) error
 }
 
 // Analyzer pushes abstracts to ML models for scoring and topic extraction.
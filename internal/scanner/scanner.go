@@ -3,6 +3,7 @@ package scanner
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"ArticlesScanner/internal/domain"
@@ -20,6 +21,36 @@ type Request struct {
 	SiteName   string
 	Categories []Category
 	Options    map[string]string
+	// Workers bounds how many categories a scanner may fetch concurrently; values below 2 mean sequential.
+	Workers int
+}
+
+// CategoryFailure is one category that could not be scanned.
+type CategoryFailure struct {
+	Category string
+	Err      error
+}
+
+// PartialError is returned alongside the articles of the categories that succeeded.
+type PartialError struct {
+	Failures []CategoryFailure
+}
+
+func (e *PartialError) Error() string {
+	parts := make([]string, 0, len(e.Failures))
+	for _, failure := range e.Failures {
+		parts = append(parts, fmt.Sprintf("category %s: %v", failure.Category, failure.Err))
+	}
+	return fmt.Sprintf("%d categories failed: %s", len(e.Failures), strings.Join(parts, "; "))
+}
+
+// Unwrap exposes the per-category causes to errors.Is and errors.As.
+func (e *PartialError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failures))
+	for _, failure := range e.Failures {
+		errs = append(errs, failure.Err)
+	}
+	return errs
 }
 
 // Scanner captures a single strategy implementation (Arxiv, IEEE, etc.).
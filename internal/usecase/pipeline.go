@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -16,24 +17,37 @@ import (
 type PipelineDeps struct {
 	Source     ports.ArticleSource
 	Repository ports.ArticleRepository
+	Versions   ports.VersionTracker
+	Runs       ports.RunStore
+	Archive    ports.ArticleArchive
+	Enricher   ports.Enricher
 	Analyzer   ports.Analyzer
 	Summarizer ports.Summarizer
 	Downloader ports.Downloader
 	Notifier   ports.Notifier
 	ChatClient ports.ChatClient
 	Logger     *slog.Logger
+
+	// RenotifyRevisions re-runs already processed articles when a newer version is announced.
+	RenotifyRevisions bool
 }
 
 // Pipeline implements the article-ingestion workflow.
 type Pipeline struct {
 	source     ports.ArticleSource
 	repository ports.ArticleRepository
+	versions   ports.VersionTracker
+	runs       ports.RunStore
+	archive    ports.ArticleArchive
+	enricher   ports.Enricher
 	analyzer   ports.Analyzer
 	summarizer ports.Summarizer
 	downloader ports.Downloader
 	notifier   ports.Notifier
 	chatClient ports.ChatClient
 	logger     *slog.Logger
+
+	renotifyRevisions bool
 }
 
 // NewPipeline constructs the orchestration component.
@@ -41,31 +55,144 @@ func NewPipeline(deps PipelineDeps) *Pipeline {
 	return &Pipeline{
 		source:     deps.Source,
 		repository: deps.Repository,
+		versions:   deps.Versions,
+		runs:       deps.Runs,
+		archive:    deps.Archive,
+		enricher:   deps.Enricher,
 		analyzer:   deps.Analyzer,
 		summarizer: deps.Summarizer,
 		downloader: deps.Downloader,
 		notifier:   deps.Notifier,
 		chatClient: deps.ChatClient,
 		logger:     deps.Logger,
+
+		renotifyRevisions: deps.RenotifyRevisions,
 	}
 }
 
-// ProcessDay orchestrates fetching, ranking, summarizing, and notifying.
+// ProcessDay orchestrates fetching, ranking, summarizing, and notifying. An unfinished run of the
+// same day is continued from its checkpoints instead of starting over.
 func (p *Pipeline) ProcessDay(ctx context.Context, day time.Time) error {
 	if p.source == nil {
 		return nil
 	}
 
-	p.debug("starting pipeline", "day", day.Format("2006-01-02"))
+	run, checkpoints, err := p.openRun(ctx, day)
+	if err != nil {
+		return err
+	}
+	return p.closeRun(ctx, &run, p.processRun(ctx, &run, checkpoints))
+}
+
+// Resume continues failed or interrupted runs of earlier days, oldest first; an unfinished run of
+// today is left to ProcessDay.
+func (p *Pipeline) Resume(ctx context.Context, today time.Time) error {
+	if p.source == nil || p.runs == nil {
+		return nil
+	}
+
+	runs, err := p.runs.UnfinishedRuns(ctx)
+	if err != nil {
+		return fmt.Errorf("load unfinished runs: %w", err)
+	}
+
+	var errs []error
+	for _, run := range runs {
+		if sameDay(run.Day, today) {
+			continue
+		}
+		checkpoints, err := p.reopenRun(ctx, &run)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := p.closeRun(ctx, &run, p.processRun(ctx, &run, checkpoints)); err != nil {
+			errs = append(errs, fmt.Errorf("resume run %d: %w", run.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// openRun continues the latest unfinished run of day or records a new one.
+func (p *Pipeline) openRun(ctx context.Context, day time.Time) (domain.PipelineRun, []domain.Checkpoint, error) {
+	run := domain.PipelineRun{Day: day, StartedAt: time.Now(), Status: domain.RunRunning}
+	if p.runs == nil {
+		return run, nil, nil
+	}
+
+	unfinished, err := p.runs.UnfinishedRuns(ctx)
+	if err != nil {
+		return run, nil, fmt.Errorf("load unfinished runs: %w", err)
+	}
+	for i := len(unfinished) - 1; i >= 0; i-- {
+		if sameDay(unfinished[i].Day, day) {
+			previous := unfinished[i]
+			checkpoints, err := p.reopenRun(ctx, &previous)
+			return previous, checkpoints, err
+		}
+	}
 
-	articles, err := p.source.FetchDaily(ctx, day)
+	run.ID, err = p.runs.StartRun(ctx, run)
+	if err != nil {
+		return run, nil, fmt.Errorf("start run: %w", err)
+	}
+	return run, nil, nil
+}
+
+// reopenRun loads the checkpoints of an unfinished run and marks it running again.
+func (p *Pipeline) reopenRun(ctx context.Context, run *domain.PipelineRun) ([]domain.Checkpoint, error) {
+	checkpoints, err := p.runs.LoadCheckpoints(ctx, run.ID)
+	if err != nil {
+		return nil, fmt.Errorf("load checkpoints of run %d: %w", run.ID, err)
+	}
+	p.info("resuming pipeline run", "run_id", run.ID, "day", run.Day.Format("2006-01-02"), "checkpoints", len(checkpoints))
+
+	run.Status = domain.RunRunning
+	run.FinishedAt = time.Time{}
+	return checkpoints, p.updateRun(ctx, *run)
+}
+
+// closeRun records how the run ended and returns runErr.
+func (p *Pipeline) closeRun(ctx context.Context, run *domain.PipelineRun, runErr error) error {
+	run.FinishedAt = time.Now()
+	run.Status = domain.RunSucceeded
+	if runErr != nil {
+		run.Status = domain.RunFailed
+		run.Errors = append(run.Errors, runErr.Error())
+	}
+	p.debug("pipeline run finished", "run_id", run.ID, "status", run.Status,
+		"fetched", run.Fetched, "ranked", run.Ranked, "summarized", run.Summarized, "delivered", run.Delivered)
+
+	if err := p.updateRun(context.WithoutCancel(ctx), *run); err != nil {
+		return errors.Join(runErr, err)
+	}
+	return runErr
+}
+
+// processRun checkpoints newly fetched articles, takes every undelivered article of the run to
+// summarized and delivers the digest.
+func (p *Pipeline) processRun(ctx context.Context, run *domain.PipelineRun, checkpoints []domain.Checkpoint) error {
+	p.debug("starting pipeline", "day", run.Day.Format("2006-01-02"), "run_id", run.ID)
+
+	articles, err := p.source.FetchDaily(ctx, run.Day)
 	if err != nil {
 		return fmt.Errorf("fetch daily: %w", err)
 	}
 	p.debug("source returned articles", "count", len(articles))
 
-	ids := make([]string, len(articles))
-	for i, art := range articles {
+	checkpointed := make(map[string]bool, len(checkpoints))
+	for _, checkpoint := range checkpoints {
+		checkpointed[checkpoint.Review.Article.ID] = true
+	}
+	var fresh []domain.Article
+	for _, article := range articles {
+		if !checkpointed[article.ID] {
+			fresh = append(fresh, article)
+		}
+	}
+
+	ids := make([]string, len(fresh))
+	for i, art := range fresh {
 		ids[i] = art.ID
 	}
 
@@ -77,92 +204,292 @@ func (p *Pipeline) ProcessDay(ctx context.Context, day time.Time) error {
 		}
 	}
 
-	var digest []domain.ArticleReview
-	for _, article := range articles {
+	// Every new article is checkpointed before any work, and only then may the source mark its sites
+	// as scanned; a crash or error up to that point makes the next attempt fetch the same list again.
+	for _, article := range fresh {
+		revised := p.recordVersion(ctx, article)
+		if skip[article.ID] && !revised {
+			p.debug("skip article (already delivered)", "article_id", article.ID)
+			continue
+		}
 		if skip[article.ID] {
-			p.debug("skip article (already processed)", "article_id", article.ID)
+			p.info("re-processing revised article", "article_id", article.ID, "version", article.Version)
+		}
+
+		checkpoint := domain.Checkpoint{
+			RunID:    run.ID,
+			Position: len(checkpoints),
+			Review:   domain.ArticleReview{Article: article, Summary: article.Abstract},
+		}
+		if err := p.advance(ctx, &checkpoint, domain.StatusFetched); err != nil {
+			return err
+		}
+		checkpoints = append(checkpoints, checkpoint)
+		run.Fetched++
+	}
+	if err := p.updateRun(ctx, *run); err != nil {
+		return err
+	}
+	if p.runs != nil {
+		p.commitScan(ctx, run.Day)
+	}
+
+	var pending []*domain.Checkpoint
+	for i := range checkpoints {
+		checkpoint := &checkpoints[i]
+		if checkpoint.Stage.Reached(domain.StatusDelivered) {
 			continue
 		}
+		if err := p.processArticle(ctx, run, checkpoint); err != nil {
+			return err
+		}
+		if err := p.updateRun(ctx, *run); err != nil {
+			return err
+		}
+		pending = append(pending, checkpoint)
+	}
+
+	if len(pending) == 0 {
+		p.debug("no articles processed", "day", run.Day.Format("2006-01-02"))
+	} else if err := p.deliver(ctx, run, pending); err != nil {
+		return err
+	}
+	// Without checkpoints the fetched list lives only in this call, so sources count as scanned
+	// only once it was delivered.
+	if p.runs == nil {
+		p.commitScan(ctx, run.Day)
+	}
+	return nil
+}
 
+// commitScan lets the source mark the day's sources as scanned. A failure only costs a rescan,
+// whose articles the checkpoints and delivered statuses filter out, so it is logged.
+func (p *Pipeline) commitScan(ctx context.Context, day time.Time) {
+	committer, ok := p.source.(ports.ScanCommitter)
+	if !ok {
+		return
+	}
+	if err := committer.CommitScan(ctx, day); err != nil {
+		p.warn("commit scan failed, sources will be rescanned", "day", day.Format("2006-01-02"), "error", err)
+	}
+}
+
+// processArticle takes an article from its checkpointed stage to summarized, skipping the
+// ranking and summarization a previous attempt already completed.
+func (p *Pipeline) processArticle(ctx context.Context, run *domain.PipelineRun, checkpoint *domain.Checkpoint) error {
+	article := checkpoint.Review.Article
+
+	if !checkpoint.Stage.Reached(domain.StatusRanked) {
 		p.debug("processing article", "article_id", article.ID)
 
+		if p.enricher != nil {
+			enrichment, eErr := p.enricher.Enrich(ctx, article)
+			if eErr != nil {
+				p.warn("enrichment failed, ranking without it", "article_id", article.ID, "error", eErr)
+			} else {
+				article.Enrichment = enrichment
+			}
+		}
+
+		if p.archive != nil {
+			if err := p.archive.SaveArticle(ctx, article); err != nil {
+				return fmt.Errorf("archive article %s: %w", article.ID, err)
+			}
+		}
+
 		review := domain.ArticleReview{
 			Article: article,
 			Summary: article.Abstract,
 		}
 
 		if p.analyzer != nil {
+			var err error
 			review, err = p.analyzer.Rank(ctx, article)
 			if err != nil {
 				return fmt.Errorf("rank article %s: %w", article.ID, err)
 			}
 		}
 
-		var payload []byte
-		if p.downloader != nil {
-			reader, dErr := p.downloader.Download(ctx, article)
-			if dErr != nil {
-				return fmt.Errorf("download article %s: %w", article.ID, dErr)
+		checkpoint.Review = review
+		if err := p.advance(ctx, checkpoint, domain.StatusRanked); err != nil {
+			return err
+		}
+		run.Ranked++
+	} else {
+		p.debug("resuming article after ranking", "article_id", article.ID, "stage", checkpoint.Stage)
+	}
+
+	if checkpoint.Stage.Reached(domain.StatusSummarized) {
+		return nil
+	}
+
+	var payload []byte
+	if p.downloader != nil {
+		reader, dErr := p.downloader.Download(ctx, article)
+		if dErr != nil {
+			return fmt.Errorf("download article %s: %w", article.ID, dErr)
+		}
+		if reader != nil {
+			data, readErr := io.ReadAll(reader)
+			closeErr := reader.Close()
+			if readErr != nil {
+				return fmt.Errorf("read article %s: %w", article.ID, readErr)
 			}
-			if reader != nil {
-				data, readErr := io.ReadAll(reader)
-				closeErr := reader.Close()
-				if readErr != nil {
-					return fmt.Errorf("read article %s: %w", article.ID, readErr)
-				}
-				if closeErr != nil {
-					return fmt.Errorf("close article %s: %w", article.ID, closeErr)
-				}
-				payload = data
+			if closeErr != nil {
+				return fmt.Errorf("close article %s: %w", article.ID, closeErr)
 			}
+			payload = data
 		}
+	}
 
-		if p.summarizer != nil {
-			summary, sErr := p.summarizer.Summarize(ctx, article, payload)
-			if sErr != nil {
-				return fmt.Errorf("summarize article %s: %w", article.ID, sErr)
-			}
-			review.Summary = summary
+	if p.summarizer != nil {
+		summary, sErr := p.summarizer.Summarize(ctx, article, payload)
+		if sErr != nil {
+			return fmt.Errorf("summarize article %s: %w", article.ID, sErr)
 		}
+		checkpoint.Review.Summary = summary
+	}
 
-		digest = append(digest, review)
+	if p.archive != nil && p.analyzer != nil {
+		if checkpoint.Review.RankedAt.IsZero() {
+			checkpoint.Review.RankedAt = time.Now()
+		}
+		if err := p.archive.SaveReview(ctx, checkpoint.Review); err != nil {
+			return fmt.Errorf("archive review %s: %w", article.ID, err)
+		}
+	}
+
+	if err := p.advance(ctx, checkpoint, domain.StatusSummarized); err != nil {
+		return err
+	}
+	run.Summarized++
+	return nil
+}
 
-		if p.repository != nil {
-			err = p.repository.SaveProcessed(ctx, domain.ProcessedArticle{
-				Article: review.Article,
-				Summary: review.Summary,
-				Score:   review.Score,
-				Status:  domain.StatusDelivered,
-			})
+// deliver sends the digest to ChatGPT (skipping articles a previous attempt already sent) and the
+// notifier, and marks the articles delivered only after both succeeded; until then they are retried.
+func (p *Pipeline) deliver(ctx context.Context, run *domain.PipelineRun, pending []*domain.Checkpoint) error {
+	if p.chatClient != nil {
+		var unsent []*domain.Checkpoint
+		for _, checkpoint := range pending {
+			if !checkpoint.SentToChat {
+				unsent = append(unsent, checkpoint)
+			}
+		}
+		if len(unsent) > 0 {
+			payload, err := buildDigestJSON(reviewsOf(unsent))
 			if err != nil {
-				return fmt.Errorf("persist article %s: %w", article.ID, err)
+				return fmt.Errorf("build chatgpt payload: %w", err)
+			}
+			if err := p.chatClient.SendDigest(ctx, payload); err != nil {
+				return fmt.Errorf("send digest to chatgpt: %w", err)
 			}
+			for _, checkpoint := range unsent {
+				checkpoint.SentToChat = true
+				if err := p.saveCheckpoint(ctx, *checkpoint); err != nil {
+					return err
+				}
+			}
+			p.debug("sent articles to chatgpt", "count", len(unsent))
 		}
 	}
 
-	if len(digest) == 0 {
-		p.debug("no articles processed", "day", day.Format("2006-01-02"))
-		return nil
+	digest := reviewsOf(pending)
+	if p.notifier != nil {
+		message := buildDigestMessage(digest)
+		p.debug("publishing digest to notifier", "bytes", len(message))
+		if err := p.notifier.PublishDigest(ctx, message); err != nil {
+			return fmt.Errorf("publish digest: %w", err)
+		}
 	}
 
-	if p.chatClient != nil {
-		payload, err := buildDigestJSON(digest)
-		if err != nil {
-			return fmt.Errorf("build chatgpt payload: %w", err)
-		}
-		if err := p.chatClient.SendDigest(ctx, payload); err != nil {
-			return fmt.Errorf("send digest to chatgpt: %w", err)
+	if err := p.markDelivered(ctx, digest); err != nil {
+		return err
+	}
+	for _, checkpoint := range pending {
+		checkpoint.Stage = domain.StatusDelivered
+		if err := p.saveCheckpoint(ctx, *checkpoint); err != nil {
+			return err
 		}
-		p.debug("sent articles to chatgpt", "count", len(digest))
 	}
+	run.Delivered += len(pending)
+	return nil
+}
 
-	if p.notifier == nil {
+// advance moves the checkpoint to stage and persists both the article status and the checkpoint.
+func (p *Pipeline) advance(ctx context.Context, checkpoint *domain.Checkpoint, stage domain.ProcessingStatus) error {
+	checkpoint.Stage = stage
+	if err := p.saveStatus(ctx, checkpoint.Review, stage); err != nil {
+		return err
+	}
+	return p.saveCheckpoint(ctx, *checkpoint)
+}
+
+func (p *Pipeline) saveCheckpoint(ctx context.Context, checkpoint domain.Checkpoint) error {
+	if p.runs == nil || checkpoint.RunID == 0 {
 		return nil
 	}
+	checkpoint.UpdatedAt = time.Now()
+	if err := p.runs.SaveCheckpoint(ctx, checkpoint); err != nil {
+		return fmt.Errorf("checkpoint article %s: %w", checkpoint.Review.Article.ID, err)
+	}
+	return nil
+}
 
-	message := buildDigestMessage(digest)
-	p.debug("publishing digest to notifier", "bytes", len(message))
-	return p.notifier.PublishDigest(ctx, message)
+func (p *Pipeline) updateRun(ctx context.Context, run domain.PipelineRun) error {
+	if p.runs == nil || run.ID == 0 {
+		return nil
+	}
+	if err := p.runs.UpdateRun(ctx, run); err != nil {
+		return fmt.Errorf("update run %d: %w", run.ID, err)
+	}
+	return nil
+}
+
+func reviewsOf(checkpoints []*domain.Checkpoint) []domain.ArticleReview {
+	reviews := make([]domain.ArticleReview, len(checkpoints))
+	for i, checkpoint := range checkpoints {
+		reviews[i] = checkpoint.Review
+	}
+	return reviews
+}
+
+// sameDay compares calendar dates in the location of a.
+func sameDay(a, b time.Time) bool {
+	return a.Format("2006-01-02") == b.In(a.Location()).Format("2006-01-02")
+}
+
+// saveStatus records that the article reached status, keeping the latest summary and score.
+func (p *Pipeline) saveStatus(ctx context.Context, review domain.ArticleReview, status domain.ProcessingStatus) error {
+	if p.repository == nil {
+		return nil
+	}
+	err := p.repository.SaveProcessed(ctx, domain.ProcessedArticle{
+		Article: review.Article,
+		Summary: review.Summary,
+		Score:   review.Score,
+		Status:  status,
+	})
+	if err != nil {
+		return fmt.Errorf("persist article %s as %s: %w", review.Article.ID, status, err)
+	}
+	return nil
+}
+
+// markDelivered runs only after every notifier succeeded; until then the articles are retried on the next run.
+func (p *Pipeline) markDelivered(ctx context.Context, digest []domain.ArticleReview) error {
+	if p.repository == nil {
+		return nil
+	}
+	ids := make([]string, len(digest))
+	for i, review := range digest {
+		ids[i] = review.Article.ID
+	}
+	if err := p.repository.UpdateStatus(ctx, ids, domain.StatusDelivered); err != nil {
+		return fmt.Errorf("mark delivered: %w", err)
+	}
+	p.debug("marked articles delivered", "count", len(ids))
+	return nil
 }
 
 func buildDigestMessage(reviews []domain.ArticleReview) string {
@@ -184,29 +511,83 @@ func buildDigestMessage(reviews []domain.ArticleReview) string {
 
 func buildDigestJSON(reviews []domain.ArticleReview) ([]byte, error) {
 	type item struct {
-		ID      string `json:"id"`
-		URL     string `json:"url"`
-		Summary string `json:"summary"`
-		Source  string `json:"source"`
-		Title   string `json:"title"`
+		ID                  string   `json:"id"`
+		URL                 string   `json:"url"`
+		Summary             string   `json:"summary"`
+		Source              string   `json:"source"`
+		Title               string   `json:"title"`
+		Citations           *int     `json:"citations,omitempty"`
+		Venue               string   `json:"venue,omitempty"`
+		Authors             []string `json:"authors,omitempty"`
+		PrimaryCategory     string   `json:"primaryCategory,omitempty"`
+		SecondaryCategories []string `json:"secondaryCategories,omitempty"`
+		Comments            string   `json:"comments,omitempty"`
+		JournalRef          string   `json:"journalRef,omitempty"`
+		Announcement        string   `json:"announcement,omitempty"`
+		Version             int      `json:"version,omitempty"`
 	}
 
 	payload := make([]item, 0, len(reviews))
 	for _, review := range reviews {
-		payload = append(payload, item{
-			ID:      review.Article.ID,
-			URL:     review.Article.URL,
-			Summary: review.Summary,
-			Source:  review.Article.Source,
-			Title:   review.Article.Title,
-		})
+		entry := item{
+			ID:                  review.Article.ID,
+			URL:                 review.Article.URL,
+			Summary:             review.Summary,
+			Source:              review.Article.Source,
+			Title:               review.Article.Title,
+			Venue:               review.Article.Venue,
+			Authors:             review.Article.Authors,
+			PrimaryCategory:     review.Article.PrimaryCategory,
+			SecondaryCategories: review.Article.SecondaryCategories,
+			Comments:            review.Article.Comments,
+			JournalRef:          review.Article.JournalRef,
+			Announcement:        string(review.Article.Announcement),
+			Version:             review.Article.Version,
+		}
+		if enrichment := review.Article.Enrichment; enrichment != nil {
+			entry.Citations = &enrichment.CitationCount
+			if entry.Venue == "" {
+				entry.Venue = enrichment.Venue
+			}
+		}
+		payload = append(payload, entry)
 	}
 
 	return json.Marshal(payload)
 }
 
+// recordVersion stores the announced version and reports whether it supersedes one seen earlier
+// and revisions should be re-notified.
+func (p *Pipeline) recordVersion(ctx context.Context, article domain.Article) bool {
+	if p.versions == nil || article.Version <= 0 {
+		return false
+	}
+	previous, err := p.versions.RecordVersion(ctx, article.ID, article.Version)
+	if err != nil {
+		p.warn("record article version failed", "article_id", article.ID, "error", err)
+		return false
+	}
+	if previous == 0 || article.Version <= previous {
+		return false
+	}
+	p.debug("new article version", "article_id", article.ID, "previous", previous, "version", article.Version)
+	return p.renotifyRevisions
+}
+
 func (p *Pipeline) debug(msg string, args ...interface{}) {
 	if p.logger != nil {
 		p.logger.Debug(msg, args...)
 	}
 }
+
+func (p *Pipeline) info(msg string, args ...interface{}) {
+	if p.logger != nil {
+		p.logger.Info(msg, args...)
+	}
+}
+
+func (p *Pipeline) warn(msg string, args ...interface{}) {
+	if p.logger != nil {
+		p.logger.Warn(msg, args...)
+	}
+}
@@ -0,0 +1,489 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"ArticlesScanner/internal/domain"
+	"ArticlesScanner/internal/infrastructure/ml"
+	"ArticlesScanner/internal/infrastructure/storage/memory"
+)
+
+type staticSource struct {
+	articles []domain.Article
+}
+
+func (s staticSource) FetchDaily(context.Context, time.Time) ([]domain.Article, error) {
+	return s.articles, nil
+}
+
+// statusRepository keeps the latest status per article and the order of transitions.
+type statusRepository struct {
+	mu          sync.Mutex
+	status      map[string]domain.ProcessingStatus
+	transitions []string
+}
+
+func (r *statusRepository) AlreadyProcessed(_ context.Context, ids []string) (map[string]bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	result := map[string]bool{}
+	for _, id := range ids {
+		if r.status[id] == domain.StatusDelivered {
+			result[id] = true
+		}
+	}
+	return result, nil
+}
+
+func (r *statusRepository) SaveProcessed(_ context.Context, article domain.ProcessedArticle) error {
+	return r.UpdateStatus(context.Background(), []string{article.Article.ID}, article.Status)
+}
+
+func (r *statusRepository) UpdateStatus(_ context.Context, ids []string, status domain.ProcessingStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.status == nil {
+		r.status = map[string]domain.ProcessingStatus{}
+	}
+	for _, id := range ids {
+		r.status[id] = status
+		r.transitions = append(r.transitions, id+":"+string(status))
+	}
+	return nil
+}
+
+type flakyNotifier struct {
+	err      error
+	messages []string
+}
+
+func (n *flakyNotifier) PublishDigest(_ context.Context, digest string) error {
+	if n.err != nil {
+		return n.err
+	}
+	n.messages = append(n.messages, digest)
+	return nil
+}
+
+func TestProcessDayDeliversOnlyAfterNotifierSucceeds(t *testing.T) {
+	t.Parallel()
+
+	repo := &statusRepository{}
+	notifier := &flakyNotifier{err: errors.New("telegram unavailable")}
+	pipeline := NewPipeline(PipelineDeps{
+		Source:     staticSource{articles: []domain.Article{{ID: "a", Title: "A"}, {ID: "b", Title: "B"}}},
+		Repository: repo,
+		Notifier:   notifier,
+	})
+	day := time.Date(2025, time.November, 11, 6, 0, 0, 0, time.UTC)
+
+	if err := pipeline.ProcessDay(context.Background(), day); err == nil {
+		t.Fatal("expected notifier failure to fail the run")
+	}
+	want := "a:fetched,b:fetched,a:ranked,a:summarized,b:ranked,b:summarized"
+	if got := strings.Join(repo.transitions, ","); got != want {
+		t.Fatalf("unexpected transitions:\n got %s\nwant %s", got, want)
+	}
+
+	notifier.err = nil
+	repo.transitions = nil
+	if err := pipeline.ProcessDay(context.Background(), day); err != nil {
+		t.Fatalf("retry ProcessDay error: %v", err)
+	}
+	if len(notifier.messages) != 1 || !strings.Contains(notifier.messages[0], "- A") || !strings.Contains(notifier.messages[0], "- B") {
+		t.Fatalf("expected undelivered articles to be retried, got %q", notifier.messages)
+	}
+	if repo.status["a"] != domain.StatusDelivered || repo.status["b"] != domain.StatusDelivered {
+		t.Fatalf("expected delivered statuses, got %v", repo.status)
+	}
+
+	if err := pipeline.ProcessDay(context.Background(), day); err != nil {
+		t.Fatalf("third ProcessDay error: %v", err)
+	}
+	if len(notifier.messages) != 1 {
+		t.Fatalf("delivered articles must not be sent again, got %d digests", len(notifier.messages))
+	}
+}
+
+// memoryRuns is an in-test RunStore.
+type memoryRuns struct {
+	mu          sync.Mutex
+	runs        []domain.PipelineRun
+	checkpoints map[int64]map[string]domain.Checkpoint
+}
+
+func (m *memoryRuns) StartRun(_ context.Context, run domain.PipelineRun) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	run.ID = int64(len(m.runs) + 1)
+	m.runs = append(m.runs, run)
+	return run.ID, nil
+}
+
+func (m *memoryRuns) UpdateRun(_ context.Context, run domain.PipelineRun) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.runs[run.ID-1] = run
+	return nil
+}
+
+func (m *memoryRuns) UnfinishedRuns(context.Context) ([]domain.PipelineRun, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var runs []domain.PipelineRun
+	for _, run := range m.runs {
+		if !run.Finished() {
+			runs = append(runs, run)
+		}
+	}
+	return runs, nil
+}
+
+func (m *memoryRuns) SaveCheckpoint(_ context.Context, checkpoint domain.Checkpoint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.checkpoints == nil {
+		m.checkpoints = map[int64]map[string]domain.Checkpoint{}
+	}
+	if m.checkpoints[checkpoint.RunID] == nil {
+		m.checkpoints[checkpoint.RunID] = map[string]domain.Checkpoint{}
+	}
+	m.checkpoints[checkpoint.RunID][checkpoint.Review.Article.ID] = checkpoint
+	return nil
+}
+
+func (m *memoryRuns) LoadCheckpoints(_ context.Context, runID int64) ([]domain.Checkpoint, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	checkpoints := make([]domain.Checkpoint, len(m.checkpoints[runID]))
+	for _, checkpoint := range m.checkpoints[runID] {
+		checkpoints[checkpoint.Position] = checkpoint
+	}
+	return checkpoints, nil
+}
+
+type countingAnalyzer struct{ calls atomic.Int32 }
+
+func (a *countingAnalyzer) Rank(_ context.Context, article domain.Article) (domain.ArticleReview, error) {
+	a.calls.Add(1)
+	return domain.ArticleReview{Article: article, Score: 0.9, Model: "ranker"}, nil
+}
+
+// failingSummarizer fails the first summary of the article named in failOnce.
+type failingSummarizer struct {
+	failOnce string
+	calls    []string
+}
+
+func (s *failingSummarizer) Summarize(_ context.Context, article domain.Article, _ []byte) (string, error) {
+	s.calls = append(s.calls, article.ID)
+	if article.ID == s.failOnce {
+		s.failOnce = ""
+		return "", errors.New("model overloaded")
+	}
+	return "summary of " + article.ID, nil
+}
+
+type countingChat struct{ payloads []string }
+
+func (c *countingChat) SendDigest(_ context.Context, payload []byte) error {
+	c.payloads = append(c.payloads, string(payload))
+	return nil
+}
+
+func TestProcessDayResumesFromCheckpoints(t *testing.T) {
+	t.Parallel()
+
+	runs := &memoryRuns{}
+	repo := &statusRepository{}
+	analyzer := &countingAnalyzer{}
+	summarizer := &failingSummarizer{failOnce: "b"}
+	chat := &countingChat{}
+	notifier := &flakyNotifier{}
+	pipeline := NewPipeline(PipelineDeps{
+		Source:     staticSource{articles: []domain.Article{{ID: "a", Title: "A"}, {ID: "b", Title: "B"}}},
+		Repository: repo,
+		Runs:       runs,
+		Analyzer:   analyzer,
+		Summarizer: summarizer,
+		ChatClient: chat,
+		Notifier:   notifier,
+	})
+	day := time.Date(2025, time.November, 11, 6, 0, 0, 0, time.UTC)
+
+	if err := pipeline.ProcessDay(context.Background(), day); err == nil {
+		t.Fatal("expected summarizer failure to fail the run")
+	}
+	if run := runs.runs[0]; run.Status != domain.RunFailed || run.Fetched != 2 || run.Ranked != 2 || run.Summarized != 1 || len(run.Errors) != 1 {
+		t.Fatalf("unexpected failed run record: %+v", run)
+	}
+
+	// The same day continues run 1: nothing is re-ranked and only b is summarized again.
+	notifier.err = errors.New("telegram unavailable")
+	if err := pipeline.ProcessDay(context.Background(), day); err == nil {
+		t.Fatal("expected notifier failure to fail the run")
+	}
+	notifier.err = nil
+	if err := pipeline.ProcessDay(context.Background(), day); err != nil {
+		t.Fatalf("resumed ProcessDay error: %v", err)
+	}
+
+	if len(runs.runs) != 1 {
+		t.Fatalf("expected the unfinished run to be continued, got %d runs", len(runs.runs))
+	}
+	if run := runs.runs[0]; run.Status != domain.RunSucceeded || run.Delivered != 2 || run.FinishedAt.IsZero() {
+		t.Fatalf("unexpected finished run record: %+v", run)
+	}
+	if analyzer.calls.Load() != 2 {
+		t.Fatalf("expected each article ranked once, got %d calls", analyzer.calls.Load())
+	}
+	if got := strings.Join(summarizer.calls, ","); got != "a,b,b" {
+		t.Fatalf("unexpected summarizer calls: %s", got)
+	}
+	if len(chat.payloads) != 1 || !strings.Contains(chat.payloads[0], "summary of b") {
+		t.Fatalf("expected a single ChatGPT digest, got %q", chat.payloads)
+	}
+	if len(notifier.messages) != 1 || repo.status["a"] != domain.StatusDelivered || repo.status["b"] != domain.StatusDelivered {
+		t.Fatalf("expected both articles delivered once, got %q and %v", notifier.messages, repo.status)
+	}
+}
+
+func TestResumeContinuesEarlierDays(t *testing.T) {
+	t.Parallel()
+
+	runs := &memoryRuns{}
+	yesterday := time.Date(2025, time.November, 10, 6, 0, 0, 0, time.UTC)
+	id, _ := runs.StartRun(context.Background(), domain.PipelineRun{Day: yesterday, Status: domain.RunRunning})
+	_ = runs.SaveCheckpoint(context.Background(), domain.Checkpoint{
+		RunID:  id,
+		Stage:  domain.StatusSummarized,
+		Review: domain.ArticleReview{Article: domain.Article{ID: "old", Title: "Old"}, Summary: "kept"},
+	})
+
+	notifier := &flakyNotifier{}
+	analyzer := &countingAnalyzer{}
+	pipeline := NewPipeline(PipelineDeps{
+		Source:   staticSource{},
+		Runs:     runs,
+		Analyzer: analyzer,
+		Notifier: notifier,
+	})
+
+	if err := pipeline.Resume(context.Background(), yesterday.AddDate(0, 0, 1)); err != nil {
+		t.Fatalf("Resume error: %v", err)
+	}
+	if len(notifier.messages) != 1 || !strings.Contains(notifier.messages[0], "kept") || analyzer.calls.Load() != 0 {
+		t.Fatalf("expected the checkpointed summary to be delivered without ranking, got %q", notifier.messages)
+	}
+	if !runs.runs[0].Finished() {
+		t.Fatalf("expected resumed run to finish, got %+v", runs.runs[0])
+	}
+}
+
+func TestProcessDaySavesProcessedArticles(t *testing.T) {
+	t.Parallel()
+
+	repo := memory.New()
+	notifier := &flakyNotifier{}
+	pipeline := NewPipeline(PipelineDeps{
+		Source:     staticSource{articles: []domain.Article{{ID: "a", Title: "A", Version: 1}, {ID: "b", Title: "B", Version: 1}}},
+		Repository: repo,
+		Versions:   repo,
+		Runs:       repo,
+		Archive:    repo,
+		Analyzer:   &countingAnalyzer{},
+		Summarizer: &failingSummarizer{},
+		Notifier:   notifier,
+	})
+	day := time.Date(2025, time.November, 11, 6, 0, 0, 0, time.UTC)
+
+	if err := pipeline.ProcessDay(context.Background(), day); err != nil {
+		t.Fatalf("ProcessDay error: %v", err)
+	}
+
+	saved := repo.ProcessedArticles()
+	if len(saved) != 2 {
+		t.Fatalf("expected two saved articles, got %+v", saved)
+	}
+	for _, article := range saved {
+		if article.Status != domain.StatusDelivered || article.Score != 0.9 || article.Summary != "summary of "+article.Article.ID {
+			t.Fatalf("unexpected saved article: %+v", article)
+		}
+		if article.CreatedAt.IsZero() || article.UpdatedAt.Before(article.CreatedAt) {
+			t.Fatalf("expected saved timestamps, got %+v", article)
+		}
+	}
+	if article, ok := repo.Processed("b"); !ok || article.Article.Title != "B" {
+		t.Fatalf("expected b with its metadata, got %+v", article)
+	}
+
+	if err := pipeline.ProcessDay(context.Background(), day.AddDate(0, 0, 1)); err != nil {
+		t.Fatalf("second ProcessDay error: %v", err)
+	}
+	if len(notifier.messages) != 1 {
+		t.Fatalf("expected saved articles to be deduplicated, got %d digests", len(notifier.messages))
+	}
+}
+
+// committingSource returns its articles until the day is committed, like a source that skips
+// sites already scanned.
+type committingSource struct {
+	articles  []domain.Article
+	committed map[string]bool
+}
+
+func (s *committingSource) FetchDaily(_ context.Context, day time.Time) ([]domain.Article, error) {
+	if s.committed[day.Format("2006-01-02")] {
+		return nil, nil
+	}
+	return s.articles, nil
+}
+
+func (s *committingSource) CommitScan(_ context.Context, day time.Time) error {
+	if s.committed == nil {
+		s.committed = map[string]bool{}
+	}
+	s.committed[day.Format("2006-01-02")] = true
+	return nil
+}
+
+// blipRepository fails the first AlreadyProcessed call.
+type blipRepository struct {
+	*memory.Repository
+	failed bool
+}
+
+func (r *blipRepository) AlreadyProcessed(ctx context.Context, ids []string) (map[string]bool, error) {
+	if !r.failed {
+		r.failed = true
+		return nil, errors.New("db blip")
+	}
+	return r.Repository.AlreadyProcessed(ctx, ids)
+}
+
+func TestProcessDayCommitsScanOnlyAfterCheckpoints(t *testing.T) {
+	t.Parallel()
+
+	store := memory.New()
+	repo := &blipRepository{Repository: store}
+	source := &committingSource{articles: []domain.Article{{ID: "a1", Title: "A1"}}}
+	notifier := &flakyNotifier{}
+	pipeline := NewPipeline(PipelineDeps{
+		Source:     source,
+		Repository: repo,
+		Runs:       store,
+		Notifier:   notifier,
+	})
+	day := time.Date(2025, time.November, 11, 6, 0, 0, 0, time.UTC)
+
+	if err := pipeline.ProcessDay(context.Background(), day); err == nil || !strings.Contains(err.Error(), "db blip") {
+		t.Fatalf("expected the repository failure, got %v", err)
+	}
+	if len(source.committed) != 0 {
+		t.Fatal("sources must not be marked scanned before the articles are checkpointed")
+	}
+
+	if err := pipeline.ProcessDay(context.Background(), day); err != nil {
+		t.Fatalf("retry ProcessDay error: %v", err)
+	}
+	if article, ok := store.Processed("a1"); !ok || article.Status != domain.StatusDelivered {
+		t.Fatalf("expected a1 to be delivered by the retry, got %+v", article)
+	}
+	if !source.committed["2025-11-11"] || len(notifier.messages) != 1 {
+		t.Fatalf("expected one digest and a committed scan, got %d digests, %v", len(notifier.messages), source.committed)
+	}
+}
+
+// brokenCheckpoints fails the first SaveCheckpoint call.
+type brokenCheckpoints struct {
+	*memory.Repository
+	failed bool
+}
+
+func (r *brokenCheckpoints) SaveCheckpoint(ctx context.Context, checkpoint domain.Checkpoint) error {
+	if !r.failed {
+		r.failed = true
+		return errors.New("disk full")
+	}
+	return r.Repository.SaveCheckpoint(ctx, checkpoint)
+}
+
+func TestProcessDayRefetchesWhenCheckpointingFails(t *testing.T) {
+	t.Parallel()
+
+	store := memory.New()
+	runs := &brokenCheckpoints{Repository: store}
+	source := &committingSource{articles: []domain.Article{{ID: "a1", Title: "A1"}, {ID: "a2", Title: "A2"}}}
+	notifier := &flakyNotifier{}
+	pipeline := NewPipeline(PipelineDeps{
+		Source:     source,
+		Repository: store,
+		Runs:       runs,
+		Notifier:   notifier,
+	})
+	day := time.Date(2025, time.November, 11, 6, 0, 0, 0, time.UTC)
+
+	if err := pipeline.ProcessDay(context.Background(), day); err == nil || !strings.Contains(err.Error(), "disk full") {
+		t.Fatalf("expected the checkpoint failure, got %v", err)
+	}
+	if len(source.committed) != 0 {
+		t.Fatal("a run that failed to checkpoint must leave its sources unscanned")
+	}
+
+	if err := pipeline.ProcessDay(context.Background(), day); err != nil {
+		t.Fatalf("retry ProcessDay error: %v", err)
+	}
+	for _, id := range []string{"a1", "a2"} {
+		if article, ok := store.Processed(id); !ok || article.Status != domain.StatusDelivered {
+			t.Fatalf("expected %s delivered after the retry, got %+v", id, article)
+		}
+	}
+	unfinished, err := store.UnfinishedRuns(context.Background())
+	if err != nil || len(unfinished) != 0 || len(notifier.messages) != 1 {
+		t.Fatalf("expected the run to finish with one digest, got %+v, %d digests, err %v", unfinished, len(notifier.messages), err)
+	}
+}
+
+func TestProcessDayArchivesReviewsPerModel(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Title string `json:"title"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.Title == "Reported" {
+			_, _ = w.Write([]byte(`{"score":0.4,"model":"ranker-v2"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"score":0.7,"topics":["nlp"]}`))
+	}))
+	defer server.Close()
+
+	repo := memory.New()
+	pipeline := NewPipeline(PipelineDeps{
+		Source:     staticSource{articles: []domain.Article{{ID: "a", Title: "Configured"}, {ID: "b", Title: "Reported"}}},
+		Repository: repo,
+		Archive:    repo,
+		Analyzer:   ml.NewClient(server.URL, "", "ranker-v1", server.Client()),
+	})
+
+	if err := pipeline.ProcessDay(context.Background(), time.Date(2025, time.November, 11, 6, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("ProcessDay error: %v", err)
+	}
+	if reviews := repo.Reviews("a"); len(reviews) != 1 || reviews[0].Model != "ranker-v1" || reviews[0].Score != 0.7 {
+		t.Fatalf("expected the configured model on a's review, got %+v", reviews)
+	}
+	if reviews := repo.Reviews("b"); len(reviews) != 1 || reviews[0].Model != "ranker-v2" {
+		t.Fatalf("expected the reported model on b's review, got %+v", reviews)
+	}
+}
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS trg_processed_articles_updated_at ON processed_articles;
+DROP FUNCTION IF EXISTS set_processed_articles_updated_at();
+DROP TABLE IF EXISTS processed_articles;
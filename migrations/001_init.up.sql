@@ -1,5 +1,3 @@
-BEGIN;
-
 CREATE TABLE IF NOT EXISTS processed_articles (
     external_id TEXT PRIMARY KEY,
     title       TEXT NOT NULL,
@@ -23,5 +21,3 @@ CREATE TRIGGER trg_processed_articles_updated_at
 BEFORE UPDATE ON processed_articles
 FOR EACH ROW
 EXECUTE FUNCTION set_processed_articles_updated_at();
-
-COMMIT;
@@ -0,0 +1,7 @@
+ALTER TABLE processed_articles
+    DROP COLUMN IF EXISTS url,
+    DROP COLUMN IF EXISTS authors,
+    DROP COLUMN IF EXISTS primary_category,
+    DROP COLUMN IF EXISTS secondary_categories,
+    DROP COLUMN IF EXISTS comments,
+    DROP COLUMN IF EXISTS journal_ref;
@@ -0,0 +1,7 @@
+ALTER TABLE processed_articles
+    ADD COLUMN IF NOT EXISTS url                  TEXT,
+    ADD COLUMN IF NOT EXISTS authors              JSONB NOT NULL DEFAULT '[]'::jsonb,
+    ADD COLUMN IF NOT EXISTS primary_category     TEXT,
+    ADD COLUMN IF NOT EXISTS secondary_categories JSONB NOT NULL DEFAULT '[]'::jsonb,
+    ADD COLUMN IF NOT EXISTS comments             TEXT,
+    ADD COLUMN IF NOT EXISTS journal_ref          TEXT;
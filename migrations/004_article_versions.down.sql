@@ -0,0 +1,5 @@
+ALTER TABLE processed_articles
+    DROP COLUMN IF EXISTS version,
+    DROP COLUMN IF EXISTS announcement;
+
+DROP TABLE IF EXISTS article_versions;
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS article_versions (
+    external_id TEXT        NOT NULL,
+    version     INTEGER     NOT NULL,
+    recorded_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (external_id, version)
+);
+
+ALTER TABLE processed_articles
+    ADD COLUMN IF NOT EXISTS version      INTEGER,
+    ADD COLUMN IF NOT EXISTS announcement TEXT;
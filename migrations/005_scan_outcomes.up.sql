@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS scan_outcomes (
+    run_day    DATE        NOT NULL,
+    site       TEXT        NOT NULL,
+    category   TEXT        NOT NULL DEFAULT '',
+    articles   INTEGER     NOT NULL DEFAULT 0,
+    error      TEXT,
+    scanned_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (run_day, site, category)
+);
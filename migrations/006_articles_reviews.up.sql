@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS articles (
+    external_id          TEXT PRIMARY KEY,
+    title                TEXT        NOT NULL,
+    abstract             TEXT,
+    url                  TEXT,
+    source               TEXT        NOT NULL,
+    authors              JSONB       NOT NULL DEFAULT '[]'::jsonb,
+    primary_category     TEXT,
+    categories           JSONB       NOT NULL DEFAULT '[]'::jsonb,
+    doi                  TEXT,
+    venue                TEXT,
+    version              INTEGER,
+    announcement         TEXT,
+    published_at         TIMESTAMPTZ,
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at           TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_articles_published_at ON articles (published_at);
+CREATE INDEX IF NOT EXISTS idx_articles_source ON articles (source);
+
+CREATE TABLE IF NOT EXISTS reviews (
+    external_id TEXT             NOT NULL REFERENCES articles (external_id) ON DELETE CASCADE,
+    model       TEXT             NOT NULL DEFAULT '',
+    score       DOUBLE PRECISION NOT NULL,
+    topics      JSONB            NOT NULL DEFAULT '[]'::jsonb,
+    summary     TEXT,
+    ranked_at   TIMESTAMPTZ      NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (external_id, model)
+);
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS pipeline_runs (
+    id          BIGSERIAL PRIMARY KEY,
+    day         TIMESTAMPTZ NOT NULL,
+    timezone    TEXT        NOT NULL,
+    started_at  TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ,
+    status      TEXT        NOT NULL,
+    fetched     INTEGER     NOT NULL DEFAULT 0,
+    ranked      INTEGER     NOT NULL DEFAULT 0,
+    summarized  INTEGER     NOT NULL DEFAULT 0,
+    delivered   INTEGER     NOT NULL DEFAULT 0,
+    errors      JSONB       NOT NULL DEFAULT '[]'::jsonb
+);
+
+CREATE INDEX IF NOT EXISTS idx_pipeline_runs_status ON pipeline_runs (status);
+
+CREATE TABLE IF NOT EXISTS run_checkpoints (
+    run_id       BIGINT      NOT NULL REFERENCES pipeline_runs (id) ON DELETE CASCADE,
+    external_id  TEXT        NOT NULL,
+    position     INTEGER     NOT NULL,
+    stage        TEXT        NOT NULL,
+    review       JSONB       NOT NULL,
+    sent_to_chat BOOLEAN     NOT NULL DEFAULT FALSE,
+    updated_at   TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (run_id, external_id)
+);
@@ -0,0 +1,30 @@
+// Package migrations embeds the SQL schema migrations applied by the migrate runner.
+//
+// Files are named NNN_description.up.sql with a matching NNN_description.down.sql; the runner
+// wraps each file in a transaction, so they must not contain BEGIN/COMMIT themselves. The sqlite
+// directory holds the same versions adapted to SQLite types and ALTER TABLE syntax.
+package migrations
+
+import (
+	"embed"
+	"io/fs"
+)
+
+// Postgres holds the migrations for the Postgres schema.
+//
+//go:embed *.sql
+var Postgres embed.FS
+
+//go:embed sqlite/*.sql
+var sqliteFiles embed.FS
+
+// SQLite holds the migrations for the SQLite schema, rooted like Postgres.
+var SQLite = mustSub(sqliteFiles, "sqlite")
+
+func mustSub(fsys fs.FS, dir string) fs.FS {
+	sub, err := fs.Sub(fsys, dir)
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS processed_articles (
+    external_id TEXT PRIMARY KEY,
+    title       TEXT NOT NULL,
+    summary     TEXT,
+    score       REAL,
+    status      TEXT NOT NULL,
+    created_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
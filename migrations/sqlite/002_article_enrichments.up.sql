@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS article_enrichments (
+    external_id TEXT PRIMARY KEY,
+    provider    TEXT NOT NULL,
+    payload     TEXT NOT NULL,
+    fetched_at  TIMESTAMP NOT NULL
+);
@@ -0,0 +1,6 @@
+ALTER TABLE processed_articles DROP COLUMN url;
+ALTER TABLE processed_articles DROP COLUMN authors;
+ALTER TABLE processed_articles DROP COLUMN primary_category;
+ALTER TABLE processed_articles DROP COLUMN secondary_categories;
+ALTER TABLE processed_articles DROP COLUMN comments;
+ALTER TABLE processed_articles DROP COLUMN journal_ref;
@@ -0,0 +1,6 @@
+ALTER TABLE processed_articles ADD COLUMN url TEXT;
+ALTER TABLE processed_articles ADD COLUMN authors TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE processed_articles ADD COLUMN primary_category TEXT;
+ALTER TABLE processed_articles ADD COLUMN secondary_categories TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE processed_articles ADD COLUMN comments TEXT;
+ALTER TABLE processed_articles ADD COLUMN journal_ref TEXT;
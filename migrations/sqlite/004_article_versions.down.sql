@@ -0,0 +1,4 @@
+ALTER TABLE processed_articles DROP COLUMN version;
+ALTER TABLE processed_articles DROP COLUMN announcement;
+
+DROP TABLE IF EXISTS article_versions;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS article_versions (
+    external_id TEXT      NOT NULL,
+    version     INTEGER   NOT NULL,
+    recorded_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (external_id, version)
+);
+
+ALTER TABLE processed_articles ADD COLUMN version INTEGER;
+ALTER TABLE processed_articles ADD COLUMN announcement TEXT;
@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS articles (
+    external_id      TEXT PRIMARY KEY,
+    title            TEXT      NOT NULL,
+    abstract         TEXT,
+    url              TEXT,
+    source           TEXT      NOT NULL,
+    authors          TEXT      NOT NULL DEFAULT '[]',
+    primary_category TEXT,
+    categories       TEXT      NOT NULL DEFAULT '[]',
+    doi              TEXT,
+    venue            TEXT,
+    version          INTEGER,
+    announcement     TEXT,
+    published_at     TIMESTAMP,
+    created_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_articles_published_at ON articles (published_at);
+CREATE INDEX IF NOT EXISTS idx_articles_source ON articles (source);
+
+CREATE TABLE IF NOT EXISTS reviews (
+    external_id TEXT      NOT NULL REFERENCES articles (external_id) ON DELETE CASCADE,
+    model       TEXT      NOT NULL DEFAULT '',
+    score       REAL      NOT NULL,
+    topics      TEXT      NOT NULL DEFAULT '[]',
+    summary     TEXT,
+    ranked_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (external_id, model)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS run_checkpoints;
+DROP TABLE IF EXISTS pipeline_runs;
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS pipeline_runs (
+    id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    day         TIMESTAMP NOT NULL,
+    timezone    TEXT      NOT NULL,
+    started_at  TIMESTAMP NOT NULL,
+    finished_at TIMESTAMP,
+    status      TEXT      NOT NULL,
+    fetched     INTEGER   NOT NULL DEFAULT 0,
+    ranked      INTEGER   NOT NULL DEFAULT 0,
+    summarized  INTEGER   NOT NULL DEFAULT 0,
+    delivered   INTEGER   NOT NULL DEFAULT 0,
+    errors      TEXT      NOT NULL DEFAULT '[]'
+);
+
+CREATE INDEX IF NOT EXISTS idx_pipeline_runs_status ON pipeline_runs (status);
+
+CREATE TABLE IF NOT EXISTS run_checkpoints (
+    run_id       INTEGER   NOT NULL REFERENCES pipeline_runs (id) ON DELETE CASCADE,
+    external_id  TEXT      NOT NULL,
+    position     INTEGER   NOT NULL,
+    stage        TEXT      NOT NULL,
+    review       TEXT      NOT NULL,
+    sent_to_chat INTEGER   NOT NULL DEFAULT 0,
+    updated_at   TIMESTAMP NOT NULL,
+    PRIMARY KEY (run_id, external_id)
+);